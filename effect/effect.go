@@ -0,0 +1,118 @@
+package effect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MisterKaiou/go-functional/result"
+)
+
+// Of represents a deferred computation that produces a result.Of[T] when run. Nothing happens until it is given to Run,
+// so side effects can be described first and executed later.
+//
+// The zero value of Of is a computation that produces Ok holding the zero value of its type.
+type Of[T any] struct {
+	run func(context.Context) result.Of[T]
+}
+
+// PanicError is the error produced by an Of built with Attempt when its computation panics.
+type PanicError struct {
+	Value any
+}
+
+func (p *PanicError) Error() string {
+	return fmt.Sprint("effect: computation panicked: ", p.Value)
+}
+
+// Unwrap returns the value the computation panicked with if it is an error, else nil.
+func (p *PanicError) Unwrap() error {
+	err, _ := p.Value.(error)
+	return err
+}
+
+// Run executes the given Of with ctx and returns its result. If ctx is already done, the computation is not executed
+// and the returned result holds ctx.Err().
+func Run[T any](ctx context.Context, io Of[T]) result.Of[T] {
+	if err := ctx.Err(); err != nil {
+		return result.Error[T](err)
+	}
+
+	if io.run == nil {
+		var zero T
+		return result.Ok(zero)
+	}
+
+	return io.run(ctx)
+}
+
+// Ok creates a new Of that produces Ok holding the given value.
+func Ok[T any](it T) Of[T] {
+	return FromResult(result.Ok(it))
+}
+
+// Error creates a new Of that produces an error result holding the given error.
+func Error[T any](err error) Of[T] {
+	return FromResult(result.Error[T](err))
+}
+
+// FromResult creates a new Of that produces the given result.
+func FromResult[T any](res result.Of[T]) Of[T] {
+	return Of[T]{
+		run: func(context.Context) result.Of[T] { return res },
+	}
+}
+
+// FromFunc creates a new Of that calls f with the context it is run with, and produces a result from its return values.
+func FromFunc[T any](f func(context.Context) (T, error)) Of[T] {
+	return Of[T]{
+		run: func(ctx context.Context) result.Of[T] { return result.FromTupleOf(f(ctx)) },
+	}
+}
+
+// Delay creates a new Of that only calls f, and runs the Of it returns, when it is run itself.
+func Delay[T any](f func() Of[T]) Of[T] {
+	return Of[T]{
+		run: func(ctx context.Context) result.Of[T] { return Run(ctx, f()) },
+	}
+}
+
+// Map creates a new Of that applies the mapping function on the value produced by io, if it is Ok.
+func Map[T, To any](io Of[T], mapping func(T) To) Of[To] {
+	return Of[To]{
+		run: func(ctx context.Context) result.Of[To] { return result.Map(Run(ctx, io), mapping) },
+	}
+}
+
+// Bind creates a new Of that, if io produces Ok, passes its value to the binding function and runs the Of it returns.
+// If the context is done by then, the returned Of is not run.
+func Bind[T, To any](io Of[T], binding func(T) Of[To]) Of[To] {
+	return Of[To]{
+		run: func(ctx context.Context) result.Of[To] {
+			return result.Bind(Run(ctx, io), func(it T) result.Of[To] { return Run(ctx, binding(it)) })
+		},
+	}
+}
+
+// BindResult creates a new Of that applies the binding function on the value produced by io, if it is Ok. It allows
+// functions written for result.Bind to be used on an Of.
+func BindResult[T, To any](io Of[T], binding func(T) result.Of[To]) Of[To] {
+	return Of[To]{
+		run: func(ctx context.Context) result.Of[To] { return result.Bind(Run(ctx, io), binding) },
+	}
+}
+
+// Attempt creates a new Of that recovers from a panic while running io, producing an error result holding a
+// *PanicError instead.
+func Attempt[T any](io Of[T]) Of[T] {
+	return Of[T]{
+		run: func(ctx context.Context) (res result.Of[T]) {
+			defer func() {
+				if r := recover(); r != nil {
+					res = result.Error[T](&PanicError{Value: r})
+				}
+			}()
+
+			return Run(ctx, io)
+		},
+	}
+}
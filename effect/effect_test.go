@@ -0,0 +1,139 @@
+package effect
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/MisterKaiou/go-functional/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZeroValue(t *testing.T) {
+	var io Of[int]
+
+	assert.Equal(t, result.Ok(0), Run(context.Background(), io))
+}
+
+func TestOk(t *testing.T) {
+	assert.Equal(t, result.Ok(42), Run(context.Background(), Ok(42)))
+}
+
+func TestError(t *testing.T) {
+	err := errors.New("error")
+
+	assert.Equal(t, result.Error[int](err), Run(context.Background(), Error[int](err)))
+}
+
+func TestFromFuncIsLazy(t *testing.T) {
+	calls := 0
+	io := FromFunc(func(context.Context) (int, error) { calls++; return calls, nil })
+
+	assert.Equal(t, 0, calls)
+	assert.Equal(t, result.Ok(1), Run(context.Background(), io))
+	assert.Equal(t, result.Ok(2), Run(context.Background(), io))
+}
+
+func TestFromFuncReceivesContext(t *testing.T) {
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "value")
+	io := FromFunc(func(ctx context.Context) (string, error) { return ctx.Value(key{}).(string), nil })
+
+	assert.Equal(t, result.Ok("value"), Run(ctx, io))
+}
+
+func TestDelay(t *testing.T) {
+	calls := 0
+	io := Delay(func() Of[int] { calls++; return Ok(calls) })
+
+	assert.Equal(t, 0, calls)
+	assert.Equal(t, result.Ok(1), Run(context.Background(), io))
+}
+
+func TestMap(t *testing.T) {
+	err := errors.New("error")
+	toString := func(it int) string { return strconv.Itoa(it) }
+
+	assert.Equal(t, result.Ok("42"), Run(context.Background(), Map(Ok(42), toString)))
+	assert.Equal(t, result.Error[string](err), Run(context.Background(), Map(Error[int](err), toString)))
+}
+
+func TestBind(t *testing.T) {
+	err := errors.New("error")
+	calls := 0
+	half := func(it int) Of[int] {
+		calls++
+		if it%2 != 0 {
+			return Error[int](err)
+		}
+
+		return Ok(it / 2)
+	}
+
+	assert.Equal(t, result.Ok(21), Run(context.Background(), Bind(Ok(42), half)))
+	assert.Equal(t, result.Error[int](err), Run(context.Background(), Bind(Bind(Ok(42), half), half)))
+	assert.Equal(t, result.Error[int](err), Run(context.Background(), Bind(Error[int](err), half)))
+	assert.Equal(t, 3, calls)
+}
+
+func TestBindResult(t *testing.T) {
+	parse := func(s string) result.Of[int] { return result.FromTupleOf(strconv.Atoi(s)) }
+
+	parsed := Run(context.Background(), BindResult(Ok("42"), parse))
+	failed := Run(context.Background(), BindResult(Ok("nope"), parse))
+
+	assert.Equal(t, result.Ok(42), parsed)
+	assert.ErrorIs(t, failed.UnwrapError(), strconv.ErrSyntax)
+}
+
+func TestRunCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	calls := 0
+	io := FromFunc(func(context.Context) (int, error) { calls++; return 0, nil })
+
+	res := Run(ctx, io)
+
+	assert.ErrorIs(t, res.UnwrapError(), context.Canceled)
+	assert.Equal(t, 0, calls)
+}
+
+func TestCancellationStopsChain(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	first := FromFunc(func(context.Context) (int, error) { cancel(); return 1, nil })
+	second := func(it int) Of[int] {
+		return FromFunc(func(context.Context) (int, error) { calls++; return it + 1, nil })
+	}
+
+	res := Run(ctx, Bind(first, second))
+
+	assert.ErrorIs(t, res.UnwrapError(), context.Canceled)
+	assert.Equal(t, 0, calls)
+}
+
+func TestAttempt(t *testing.T) {
+	err := errors.New("error")
+	panicking := FromFunc(func(context.Context) (int, error) { panic(err) })
+
+	res := Run(context.Background(), Attempt(panicking))
+
+	var panicErr *PanicError
+	assert.ErrorAs(t, res.UnwrapError(), &panicErr)
+	assert.ErrorIs(t, res.UnwrapError(), err)
+	assert.Equal(t, "effect: computation panicked: error", panicErr.Error())
+}
+
+func TestAttemptNonError(t *testing.T) {
+	panicking := Map(Ok(1), func(int) int { panic("boom") })
+
+	res := Run(context.Background(), Attempt(panicking))
+
+	assert.EqualError(t, res.UnwrapError(), "effect: computation panicked: boom")
+	assert.Nil(t, errors.Unwrap(res.UnwrapError()))
+}
+
+func TestAttemptNoPanic(t *testing.T) {
+	assert.Equal(t, result.Ok(42), Run(context.Background(), Attempt(Ok(42))))
+}
@@ -0,0 +1,186 @@
+package either
+
+import (
+	"fmt"
+
+	"github.com/MisterKaiou/go-functional/option"
+	"github.com/MisterKaiou/go-functional/result"
+	"github.com/MisterKaiou/go-functional/unit"
+)
+
+// Of represents a value that can be either of type L (Left) or of type R (Right). By convention, Right holds the
+// value of a successful computation, and functions such as Map and Bind operate on it.
+//
+// The zero value of Of is Right holding the zero value of its type.
+type Of[L, R any] struct {
+	left   L
+	right  R
+	isLeft bool
+}
+
+// The value returned when calling this method depends on the state it represents. If Left returns "Left(v)", if Right
+// returns "Right(v)", where v is fmt.Sprint applied to its internal value.
+func (e *Of[L, R]) String() string {
+	if e.IsLeft() {
+		return fmt.Sprintf("Left(%v)", e.left)
+	}
+
+	return fmt.Sprintf("Right(%v)", e.right)
+}
+
+func (e *Of[L, R]) IsLeft() bool {
+	return e.isLeft
+}
+
+func IsLeft[L, R any](e Of[L, R]) bool {
+	return e.IsLeft()
+}
+
+func (e *Of[L, R]) IsRight() bool {
+	return !e.IsLeft()
+}
+
+func IsRight[L, R any](e Of[L, R]) bool {
+	return e.IsRight()
+}
+
+// Unwrap can panic if this Of is Left. Prefer Match over this
+func (e *Of[L, R]) Unwrap() R {
+	if e.IsLeft() {
+		panic("cannot get the right value of a left")
+	}
+
+	return e.right
+}
+
+// UnwrapLeft can panic if this Of is Right. Prefer Match over this
+func (e *Of[L, R]) UnwrapLeft() L {
+	if e.IsRight() {
+		panic("cannot get the left value of a right")
+	}
+
+	return e.left
+}
+
+// Left creates a new Of representing a Left state.
+func Left[L, R any](it L) Of[L, R] {
+	return Of[L, R]{
+		left:   it,
+		isLeft: true,
+	}
+}
+
+// Right creates a new Of representing a Right state.
+func Right[L, R any](it R) Of[L, R] {
+	return Of[L, R]{
+		right: it,
+	}
+}
+
+// Map applies the mapping function on the Of right value if it is Right, and returns a new Of.
+func Map[L, R, To any](e Of[L, R], mapping func(R) To) Of[L, To] {
+	if e.IsLeft() {
+		return Left[L, To](e.left)
+	}
+
+	return Right[L](mapping(e.right))
+}
+
+// MapLeft applies the mapping function on the Of left value if it is Left, and returns a new Of.
+func MapLeft[L, R, To any](e Of[L, R], mapping func(L) To) Of[To, R] {
+	if e.IsLeft() {
+		return Left[To, R](mapping(e.left))
+	}
+
+	return Right[To](e.right)
+}
+
+// BiMap applies leftMapping on the Of left value if it is Left, or rightMapping on its right value if it is Right, and
+// returns a new Of.
+func BiMap[L, R, ToL, ToR any](e Of[L, R], leftMapping func(L) ToL, rightMapping func(R) ToR) Of[ToL, ToR] {
+	if e.IsLeft() {
+		return Left[ToL, ToR](leftMapping(e.left))
+	}
+
+	return Right[ToL](rightMapping(e.right))
+}
+
+// Bind accepts a function that takes the Of right value and returns another Of
+func Bind[L, R, To any](e Of[L, R], binding func(R) Of[L, To]) Of[L, To] {
+	if e.IsLeft() {
+		return Left[L, To](e.left)
+	}
+
+	return binding(e.right)
+}
+
+// Match accepts two functions that return a value of the same type, the first one receives the value of a Left and the
+// second one the value of a Right.
+func Match[L, R, To any](e Of[L, R], left func(L) To, right func(R) To) To {
+	if e.IsLeft() {
+		return left(e.left)
+	}
+
+	return right(e.right)
+}
+
+// Swap turns a Left into a Right and a Right into a Left, keeping their values.
+func Swap[L, R any](e Of[L, R]) Of[R, L] {
+	if e.IsLeft() {
+		return Right[R](e.left)
+	}
+
+	return Left[R, L](e.right)
+}
+
+// Fold applies the folder function, passing the provided state and the Of right value to it and returns State.
+func Fold[L, R, State any](e Of[L, R], state State, folder func(State, R) State) State {
+	if e.IsLeft() {
+		return state
+	}
+
+	return folder(state, e.right)
+}
+
+// Iter applies the given action to the right value of the Of provided.
+func Iter[L, R any](e Of[L, R], action func(it R) unit.Unit) unit.Unit {
+	if e.IsLeft() {
+		return unit.Unit{}
+	}
+
+	return action(e.right)
+}
+
+// FromResult creates an Of from the given result.Of. An error becomes a Left holding it, and Ok becomes a Right.
+func FromResult[R any](res result.Of[R]) Of[error, R] {
+	return result.Match(res,
+		func(ok R) Of[error, R] { return Right[error](ok) },
+		func(err error) Of[error, R] { return Left[error, R](err) })
+}
+
+// ToResult creates a result.Of from the given Of. A Left becomes an error, and a Right becomes Ok.
+func ToResult[R any](e Of[error, R]) result.Of[R] {
+	if e.IsLeft() {
+		return result.Error[R](e.left)
+	}
+
+	return result.Ok(e.right)
+}
+
+// FromOption creates an Of from the given option.Of. Some becomes a Right holding its value, and None becomes a Left
+// holding the provided left value.
+func FromOption[L, R any](opt option.Of[R], left L) Of[L, R] {
+	return option.Match(opt,
+		func(it R) Of[L, R] { return Right[L](it) },
+		func() Of[L, R] { return Left[L, R](left) })
+}
+
+// ToOption creates an option.Of from the given Of. If Left, the returned option.Of will be None, else Some with the
+// right value.
+func ToOption[L, R any](e Of[L, R]) option.Of[R] {
+	if e.IsLeft() {
+		return option.None[R]()
+	}
+
+	return option.Some(e.right)
+}
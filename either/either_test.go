@@ -0,0 +1,158 @@
+package either
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/MisterKaiou/go-functional/option"
+	"github.com/MisterKaiou/go-functional/result"
+	"github.com/MisterKaiou/go-functional/unit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeft(t *testing.T) {
+	e := Left[string, int]("left")
+
+	assert.True(t, e.IsLeft())
+	assert.False(t, e.IsRight())
+	assert.Equal(t, "left", e.left)
+	assert.Zero(t, e.right)
+}
+
+func TestRight(t *testing.T) {
+	e := Right[string](42)
+
+	assert.True(t, e.IsRight())
+	assert.False(t, e.IsLeft())
+	assert.Equal(t, 42, e.right)
+	assert.Zero(t, e.left)
+}
+
+func TestZeroValue(t *testing.T) {
+	var e Of[string, int]
+
+	assert.True(t, IsRight(e))
+	assert.Equal(t, Right[string](0), e)
+}
+
+func TestLeftNil(t *testing.T) {
+	e := Left[error, int](nil)
+
+	assert.True(t, IsLeft(e))
+	assert.Nil(t, e.UnwrapLeft())
+}
+
+func TestString(t *testing.T) {
+	left := Left[string, int]("oops")
+	right := Right[string](42)
+
+	assert.Equal(t, "Left(oops)", left.String())
+	assert.Equal(t, "Right(42)", right.String())
+}
+
+func TestUnwrap(t *testing.T) {
+	left := Left[string, int]("oops")
+	right := Right[string](42)
+
+	assert.Equal(t, 42, right.Unwrap())
+	assert.Panics(t, func() { left.Unwrap() })
+	assert.Equal(t, "oops", left.UnwrapLeft())
+	assert.Panics(t, func() { right.UnwrapLeft() })
+}
+
+func TestMap(t *testing.T) {
+	right := Map(Right[string](42), func(it int) string { return fmt.Sprint(it) })
+	left := Map(Left[string, int]("oops"), func(it int) string { return fmt.Sprint(it) })
+
+	assert.Equal(t, Right[string]("42"), right)
+	assert.Equal(t, Left[string, string]("oops"), left)
+}
+
+func TestMapLeft(t *testing.T) {
+	left := MapLeft(Left[string, int]("oops"), func(it string) int { return len(it) })
+	right := MapLeft(Right[string](42), func(it string) int { return len(it) })
+
+	assert.Equal(t, Left[int, int](4), left)
+	assert.Equal(t, Right[int](42), right)
+}
+
+func TestBiMap(t *testing.T) {
+	toLen := func(it string) int { return len(it) }
+	toBool := func(it int) bool { return it > 0 }
+
+	assert.Equal(t, Left[int, bool](4), BiMap(Left[string, int]("oops"), toLen, toBool))
+	assert.Equal(t, Right[int](true), BiMap(Right[string](42), toLen, toBool))
+}
+
+func TestBind(t *testing.T) {
+	parse := func(s string) Of[error, int] {
+		it, err := strconv.Atoi(s)
+		if err != nil {
+			return Left[error, int](err)
+		}
+
+		return Right[error](it)
+	}
+
+	parsed := Bind(Right[error]("42"), parse)
+	failed := Bind(Right[error]("nope"), parse)
+	err := errors.New("error")
+	skipped := Bind(Left[error, string](err), parse)
+
+	assert.Equal(t, Right[error](42), parsed)
+	assert.True(t, IsLeft(failed))
+	assert.ErrorIs(t, failed.left, strconv.ErrSyntax)
+	assert.Same(t, err, skipped.left)
+}
+
+func TestMatch(t *testing.T) {
+	left := Match(Left[string, int]("oops"),
+		func(it string) string { return "left " + it },
+		func(it int) string { return fmt.Sprint("right ", it) })
+	right := Match(Right[string](42),
+		func(it string) string { return "left " + it },
+		func(it int) string { return fmt.Sprint("right ", it) })
+
+	assert.Equal(t, "left oops", left)
+	assert.Equal(t, "right 42", right)
+}
+
+func TestSwap(t *testing.T) {
+	assert.Equal(t, Right[int]("oops"), Swap(Left[string, int]("oops")))
+	assert.Equal(t, Left[int, string](42), Swap(Right[string](42)))
+}
+
+func TestFold(t *testing.T) {
+	sum := func(s int, i int) int { return s + i }
+
+	assert.Equal(t, 777, Fold(Right[string](667), 110, sum))
+	assert.Equal(t, 110, Fold(Left[string, int]("oops"), 110, sum))
+}
+
+func TestIter(t *testing.T) {
+	value := 0
+	incrementPtr := func(i *int) unit.Unit { *i++; return unit.Unit{} }
+
+	Iter(Right[string](&value), incrementPtr)
+	Iter(Left[string, *int]("oops"), incrementPtr)
+
+	assert.Equal(t, 1, value)
+}
+
+func TestResultConversions(t *testing.T) {
+	err := errors.New("error")
+
+	assert.Equal(t, Right[error](42), FromResult(result.Ok(42)))
+	assert.Equal(t, Left[error, int](err), FromResult(result.Error[int](err)))
+	assert.Equal(t, result.Ok(42), ToResult(Right[error](42)))
+	assert.Equal(t, result.Error[int](err), ToResult(Left[error, int](err)))
+}
+
+func TestOptionConversions(t *testing.T) {
+	assert.Equal(t, Right[string](42), FromOption(option.Some(42), "missing"))
+	assert.Equal(t, Left[string, int]("missing"), FromOption(option.None[int](), "missing"))
+	assert.Equal(t, option.Some(42), ToOption(Right[string](42)))
+	assert.Equal(t, option.None[int](), ToOption(Left[string, int]("missing")))
+}
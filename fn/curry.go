@@ -0,0 +1,89 @@
+package fn
+
+// Identity returns its argument.
+func Identity[A any](it A) A {
+	return it
+}
+
+// Const returns a function that ignores its argument and always returns it.
+func Const[In, A any](it A) func(In) A {
+	return func(In) A { return it }
+}
+
+// Flip returns a function that calls f with its two arguments swapped.
+func Flip[A, B, C any](f func(A, B) C) func(B, A) C {
+	return func(b B, a A) C { return f(a, b) }
+}
+
+// Partial returns a function that calls f with a as its first argument and its own argument as the second.
+func Partial[A, B, C any](f func(A, B) C, a A) func(B) C {
+	return func(b B) C { return f(a, b) }
+}
+
+// Curry2 turns a function of 2 arguments into a chain of functions of one argument each.
+func Curry2[A, B, R any](f func(A, B) R) func(A) func(B) R {
+	return func(a A) func(B) R {
+		return func(b B) R {
+			return f(a, b)
+		}
+	}
+}
+
+// Uncurry2 turns a chain of 2 functions of one argument each into a function of 2 arguments.
+func Uncurry2[A, B, R any](f func(A) func(B) R) func(A, B) R {
+	return func(a A, b B) R { return f(a)(b) }
+}
+
+// Curry3 turns a function of 3 arguments into a chain of functions of one argument each.
+func Curry3[A, B, C, R any](f func(A, B, C) R) func(A) func(B) func(C) R {
+	return func(a A) func(B) func(C) R {
+		return func(b B) func(C) R {
+			return func(c C) R {
+				return f(a, b, c)
+			}
+		}
+	}
+}
+
+// Uncurry3 turns a chain of 3 functions of one argument each into a function of 3 arguments.
+func Uncurry3[A, B, C, R any](f func(A) func(B) func(C) R) func(A, B, C) R {
+	return func(a A, b B, c C) R { return f(a)(b)(c) }
+}
+
+// Curry4 turns a function of 4 arguments into a chain of functions of one argument each.
+func Curry4[A, B, C, D, R any](f func(A, B, C, D) R) func(A) func(B) func(C) func(D) R {
+	return func(a A) func(B) func(C) func(D) R {
+		return func(b B) func(C) func(D) R {
+			return func(c C) func(D) R {
+				return func(d D) R {
+					return f(a, b, c, d)
+				}
+			}
+		}
+	}
+}
+
+// Uncurry4 turns a chain of 4 functions of one argument each into a function of 4 arguments.
+func Uncurry4[A, B, C, D, R any](f func(A) func(B) func(C) func(D) R) func(A, B, C, D) R {
+	return func(a A, b B, c C, d D) R { return f(a)(b)(c)(d) }
+}
+
+// Curry5 turns a function of 5 arguments into a chain of functions of one argument each.
+func Curry5[A, B, C, D, E, R any](f func(A, B, C, D, E) R) func(A) func(B) func(C) func(D) func(E) R {
+	return func(a A) func(B) func(C) func(D) func(E) R {
+		return func(b B) func(C) func(D) func(E) R {
+			return func(c C) func(D) func(E) R {
+				return func(d D) func(E) R {
+					return func(e E) R {
+						return f(a, b, c, d, e)
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurry5 turns a chain of 5 functions of one argument each into a function of 5 arguments.
+func Uncurry5[A, B, C, D, E, R any](f func(A) func(B) func(C) func(D) func(E) R) func(A, B, C, D, E) R {
+	return func(a A, b B, c C, d D, e E) R { return f(a)(b)(c)(d)(e) }
+}
@@ -0,0 +1,85 @@
+package fn
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/MisterKaiou/go-functional/option"
+	"github.com/MisterKaiou/go-functional/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentity(t *testing.T) {
+	assert.Equal(t, 42, Identity(42))
+	assert.Equal(t, option.Some(42), option.Bind(option.Some(option.Some(42)), Identity[option.Of[int]]))
+}
+
+func TestConst(t *testing.T) {
+	assert.Equal(t, option.Some("fixed"), option.Map(option.Some(42), Const[int]("fixed")))
+}
+
+func TestFlip(t *testing.T) {
+	hasPrefix := Flip(strings.HasPrefix)
+
+	assert.True(t, hasPrefix("go", "golang"))
+}
+
+func TestPartial(t *testing.T) {
+	withGo := Partial(strings.HasPrefix, "golang")
+
+	assert.Equal(t, option.Some(true), option.Map(option.Some("go"), withGo))
+	assert.Equal(t, option.Some(false), option.Map(option.Some("rust"), withGo))
+}
+
+func TestCurry2(t *testing.T) {
+	repeat := Curry2(strings.Repeat)
+
+	assert.Equal(t, "ababab", repeat("ab")(3))
+	assert.Equal(t, result.Ok("xx"), result.Map(result.Ok(2), repeat("x")))
+	assert.Equal(t, "ab", Uncurry2(repeat)("ab", 1))
+}
+
+func TestCurry3(t *testing.T) {
+	replace := func(s, old, new string) string { return strings.ReplaceAll(s, old, new) }
+	curried := Curry3(replace)
+
+	assert.Equal(t, "b-b", curried("a-a")("a")("b"))
+	assert.Equal(t, "b-b", Uncurry3(curried)("a-a", "a", "b"))
+}
+
+func TestCurry4(t *testing.T) {
+	join := func(a string, b int, c bool, d float64) string { return fmt.Sprint(a, b, c, d) }
+	curried := Curry4(join)
+
+	assert.Equal(t, "a1 true 1.5", curried("a")(1)(true)(1.5))
+	assert.Equal(t, "a1 true 1.5", Uncurry4(curried)("a", 1, true, 1.5))
+}
+
+func TestCurry5(t *testing.T) {
+	sum := func(a, b, c, d, e int) int { return a + b + c + d + e }
+	curried := Curry5(sum)
+
+	assert.Equal(t, 15, curried(1)(2)(3)(4)(5))
+	assert.Equal(t, 15, Uncurry5(curried)(1, 2, 3, 4, 5))
+}
+
+func TestCurryWithApply(t *testing.T) {
+	format := Curry2(func(name string, age int) string { return fmt.Sprintf("%s is %d", name, age) })
+
+	greeting := option.Apply(option.Map(option.Some("Kaiou"), format), option.Some(30))
+
+	assert.Equal(t, option.Some("Kaiou is 30"), greeting)
+}
+
+func TestCurryWithBind(t *testing.T) {
+	parseBase := func(base int, s string) result.Of[int64] {
+		return result.FromTupleOf(strconv.ParseInt(s, base, 64))
+	}
+
+	hex := result.Bind(result.Ok("ff"), Curry2(parseBase)(16))
+
+	assert.Equal(t, result.Ok[int64](255), hex)
+	assert.True(t, result.IsError(result.Bind(result.Ok("zz"), Partial(parseBase, 16))))
+}
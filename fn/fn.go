@@ -0,0 +1,69 @@
+package fn
+
+// Compose returns a function that applies f and then g to its argument, that is, g(f(it)). Functions are given in the
+// same order as they would be written when nesting the calls; use Flow to give them in the order they are applied.
+func Compose[A, B, C any](g func(B) C, f func(A) B) func(A) C {
+	return func(it A) C { return g(f(it)) }
+}
+
+// Flow returns a function that applies f and then g to its argument, that is, g(f(it)).
+func Flow[A, B, C any](f func(A) B, g func(B) C) func(A) C {
+	return func(it A) C { return g(f(it)) }
+}
+
+// Pipe2 passes it through the 2 given functions, from left to right, and returns the value produced by the last one.
+func Pipe2[A, B, C any](it A, f1 func(A) B, f2 func(B) C) C {
+	return f2(f1(it))
+}
+
+// Pipe3 passes it through the 3 given functions, from left to right, and returns the value produced by the last one.
+func Pipe3[A, B, C, D any](it A, f1 func(A) B, f2 func(B) C, f3 func(C) D) D {
+	return f3(f2(f1(it)))
+}
+
+// Pipe4 passes it through the 4 given functions, from left to right, and returns the value produced by the last one.
+func Pipe4[A, B, C, D, E any](it A, f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E) E {
+	return f4(f3(f2(f1(it))))
+}
+
+// Pipe5 passes it through the 5 given functions, from left to right, and returns the value produced by the last one.
+func Pipe5[A, B, C, D, E, F any](it A, f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F) F {
+	return f5(f4(f3(f2(f1(it)))))
+}
+
+// Pipe6 passes it through the 6 given functions, from left to right, and returns the value produced by the last one.
+func Pipe6[A, B, C, D, E, F, G any](
+	it A, f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G,
+) G {
+	return f6(f5(f4(f3(f2(f1(it))))))
+}
+
+// Pipe7 passes it through the 7 given functions, from left to right, and returns the value produced by the last one.
+func Pipe7[A, B, C, D, E, F, G, H any](
+	it A, f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G, f7 func(G) H,
+) H {
+	return f7(f6(f5(f4(f3(f2(f1(it)))))))
+}
+
+// Pipe8 passes it through the 8 given functions, from left to right, and returns the value produced by the last one.
+func Pipe8[A, B, C, D, E, F, G, H, I any](
+	it A, f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G, f7 func(G) H, f8 func(H) I,
+) I {
+	return f8(f7(f6(f5(f4(f3(f2(f1(it))))))))
+}
+
+// Pipe9 passes it through the 9 given functions, from left to right, and returns the value produced by the last one.
+func Pipe9[A, B, C, D, E, F, G, H, I, J any](
+	it A, f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G, f7 func(G) H, f8 func(H) I,
+	f9 func(I) J,
+) J {
+	return f9(f8(f7(f6(f5(f4(f3(f2(f1(it)))))))))
+}
+
+// Pipe10 passes it through the 10 given functions, from left to right, and returns the value produced by the last one.
+func Pipe10[A, B, C, D, E, F, G, H, I, J, K any](
+	it A, f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G, f7 func(G) H, f8 func(H) I,
+	f9 func(I) J, f10 func(J) K,
+) K {
+	return f10(f9(f8(f7(f6(f5(f4(f3(f2(f1(it))))))))))
+}
@@ -0,0 +1,71 @@
+package fn
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/MisterKaiou/go-functional/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func inc(it int) int { return it + 1 }
+
+func TestCompose(t *testing.T) {
+	f := Compose(strconv.Itoa, inc)
+
+	assert.Equal(t, "42", f(41))
+}
+
+func TestFlow(t *testing.T) {
+	f := Flow(strings.TrimSpace, strings.ToUpper)
+
+	assert.Equal(t, "HELLO", f("  hello "))
+}
+
+func TestPipe(t *testing.T) {
+	assert.Equal(t, 2, Pipe2(0, inc, inc))
+	assert.Equal(t, 3, Pipe3(0, inc, inc, inc))
+	assert.Equal(t, 4, Pipe4(0, inc, inc, inc, inc))
+	assert.Equal(t, 5, Pipe5(0, inc, inc, inc, inc, inc))
+	assert.Equal(t, 6, Pipe6(0, inc, inc, inc, inc, inc, inc))
+	assert.Equal(t, 7, Pipe7(0, inc, inc, inc, inc, inc, inc, inc))
+	assert.Equal(t, 8, Pipe8(0, inc, inc, inc, inc, inc, inc, inc, inc))
+	assert.Equal(t, 9, Pipe9(0, inc, inc, inc, inc, inc, inc, inc, inc, inc))
+	assert.Equal(t, 10, Pipe10(0, inc, inc, inc, inc, inc, inc, inc, inc, inc, inc))
+}
+
+func TestPipeChangesTypes(t *testing.T) {
+	out := Pipe4(" 41 ", strings.TrimSpace, func(s string) int { n, _ := strconv.Atoi(s); return n }, inc, strconv.Itoa)
+
+	assert.Equal(t, "42", out)
+}
+
+func TestPipeWithResultComposeK(t *testing.T) {
+	errEmpty := errors.New("empty")
+	nonEmpty := func(s string) result.Of[string] {
+		if s == "" {
+			return result.Error[string](errEmpty)
+		}
+
+		return result.Ok(s)
+	}
+	parse := func(s string) result.Of[int] { return result.FromTupleOf(strconv.Atoi(s)) }
+	parseField := result.ComposeK(nonEmpty, parse)
+
+	describe := func(in string) string {
+		return Pipe3(in,
+			strings.TrimSpace,
+			parseField,
+			func(res result.Of[int]) string {
+				return result.Match(res,
+					func(it int) string { return fmt.Sprint("got ", it) },
+					func(err error) string { return "failed: " + err.Error() })
+			})
+	}
+
+	assert.Equal(t, "got 42", describe(" 42 "))
+	assert.Equal(t, "failed: empty", describe("  "))
+}
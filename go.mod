@@ -1,6 +1,6 @@
 module github.com/MisterKaiou/go-functional
 
-go 1.18
+go 1.24
 
 require github.com/stretchr/testify v1.8.0
 
@@ -0,0 +1,157 @@
+// Package codec contains the text and binary encoding of inner values shared by the option and result packages.
+package codec
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// MarshalText encodes the value ptr points to as text. The value is encoded with its encoding.TextMarshaler
+// implementation if it has one, including one with pointer receivers when the value is itself a pointer. Otherwise,
+// strings, booleans and numbers are formatted with strconv.
+func MarshalText(ptr any) ([]byte, error) {
+	if it, ok := implementation[encoding.TextMarshaler](ptr, false); ok {
+		return it.MarshalText()
+	}
+
+	rv := reflect.ValueOf(ptr).Elem()
+	switch rv.Kind() {
+	case reflect.String:
+		return []byte(rv.String()), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(nil, rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, rv.Float(), 'g', -1, rv.Type().Bits()), nil
+	}
+
+	return nil, fmt.Errorf("cannot encode %s as text", rv.Type())
+}
+
+// UnmarshalText decodes data into the value ptr points to, following the same rules as MarshalText. If the value is a
+// nil pointer whose type implements the unmarshaler, it is set to a new value first.
+func UnmarshalText(data []byte, ptr any) error {
+	if it, ok := implementation[encoding.TextUnmarshaler](ptr, true); ok {
+		return it.UnmarshalText(data)
+	}
+
+	rv := reflect.ValueOf(ptr).Elem()
+	text := string(data)
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(text)
+		return nil
+	case reflect.Bool:
+		it, err := strconv.ParseBool(text)
+		if err == nil {
+			rv.SetBool(it)
+		}
+		return err
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		it, err := strconv.ParseInt(text, 10, rv.Type().Bits())
+		if err == nil {
+			rv.SetInt(it)
+		}
+		return err
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		it, err := strconv.ParseUint(text, 10, rv.Type().Bits())
+		if err == nil {
+			rv.SetUint(it)
+		}
+		return err
+	case reflect.Float32, reflect.Float64:
+		it, err := strconv.ParseFloat(text, rv.Type().Bits())
+		if err == nil {
+			rv.SetFloat(it)
+		}
+		return err
+	}
+
+	return fmt.Errorf("cannot decode text into %s", rv.Type())
+}
+
+// IsNil reports whether the value ptr points to is a nil pointer, slice, map, channel, function or interface. Such
+// values are not encoded by MarshalBinary, since encoding/gob cannot tell them apart from their zero value, so callers
+// must mark them in their own format instead.
+func IsNil(ptr any) bool {
+	rv := reflect.ValueOf(ptr).Elem()
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func, reflect.Interface:
+		return rv.IsNil()
+	}
+
+	return false
+}
+
+// MarshalBinary encodes the value ptr points to with its encoding.BinaryMarshaler implementation if it has one,
+// including one with pointer receivers when the value is itself a pointer, otherwise with encoding/gob.
+func MarshalBinary(ptr any) ([]byte, error) {
+	if it, ok := implementation[encoding.BinaryMarshaler](ptr, false); ok {
+		return it.MarshalBinary()
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(gobTarget(ptr, false)); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes data into the value ptr points to, following the same rules as MarshalBinary. If the value
+// is a nil pointer, it is set to a new value first.
+func UnmarshalBinary(data []byte, ptr any) error {
+	if it, ok := implementation[encoding.BinaryUnmarshaler](ptr, true); ok {
+		return it.UnmarshalBinary(data)
+	}
+
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(gobTarget(ptr, true))
+}
+
+// implementation returns ptr as an I if it implements it. Otherwise, if ptr points to a pointer whose type implements
+// I, that pointer is returned instead. A nil pointer is only used if allocate is true, in which case it is first set to a
+// new value, so that it can be decoded into.
+func implementation[I any](ptr any, allocate bool) (I, bool) {
+	if it, ok := ptr.(I); ok {
+		return it, true
+	}
+
+	var zero I
+	rv := reflect.ValueOf(ptr).Elem()
+	if rv.Kind() != reflect.Pointer || !rv.Type().Implements(reflect.TypeFor[I]()) {
+		return zero, false
+	}
+
+	if rv.IsNil() {
+		if !allocate {
+			return zero, false
+		}
+
+		rv.Set(reflect.New(rv.Type().Elem()))
+	}
+
+	return rv.Interface().(I), true
+}
+
+// gobTarget returns the value given to encoding/gob for ptr. When ptr points to a pointer, that pointer is used
+// instead, since encoding/gob cannot encode a type through two pointers when it implements gob.GobEncoder. A nil
+// pointer is only used if allocate is true, in which case it is first set to a new value.
+func gobTarget(ptr any, allocate bool) any {
+	rv := reflect.ValueOf(ptr).Elem()
+	if rv.Kind() != reflect.Pointer || (rv.IsNil() && !allocate) {
+		return ptr
+	}
+
+	if rv.IsNil() {
+		rv.Set(reflect.New(rv.Type().Elem()))
+	}
+
+	return rv.Interface()
+}
@@ -0,0 +1,118 @@
+package codec
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTextRoundTrip(t *testing.T) {
+	type named string
+	var str named
+	var num int16
+	var unsigned uint
+	var float float32
+	var flag bool
+	var date time.Time
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, roundTripText(named("text"), &str))
+	assert.NoError(t, roundTripText(int16(-3), &num))
+	assert.NoError(t, roundTripText(uint(3), &unsigned))
+	assert.NoError(t, roundTripText(float32(0.25), &float))
+	assert.NoError(t, roundTripText(true, &flag))
+	assert.NoError(t, roundTripText(now, &date))
+
+	assert.Equal(t, named("text"), str)
+	assert.Equal(t, int16(-3), num)
+	assert.Equal(t, uint(3), unsigned)
+	assert.Equal(t, float32(0.25), float)
+	assert.True(t, flag)
+	assert.Equal(t, now, date)
+}
+
+func TestTextUnsupported(t *testing.T) {
+	var it map[string]int
+
+	_, err := MarshalText(&it)
+	assert.Error(t, err)
+
+	err = UnmarshalText([]byte("{}"), &it)
+	assert.Error(t, err)
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	type payload struct {
+		Name  string
+		Items []int
+	}
+	var decoded payload
+	var date time.Time
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, roundTripBinary(payload{"name", []int{1, 2}}, &decoded))
+	assert.NoError(t, roundTripBinary(now, &date))
+
+	assert.Equal(t, payload{"name", []int{1, 2}}, decoded)
+	assert.Equal(t, now, date)
+}
+
+type counter struct{ n int }
+
+func (c *counter) MarshalBinary() ([]byte, error) {
+	return []byte{byte(c.n)}, nil
+}
+
+func (c *counter) UnmarshalBinary(data []byte) error {
+	c.n = int(data[0])
+	return nil
+}
+
+func TestPointerReceivers(t *testing.T) {
+	var num *big.Int
+	var count *counter
+
+	assert.NoError(t, roundTripText(big.NewInt(5), &num))
+	assert.NoError(t, roundTripBinary(&counter{7}, &count))
+
+	assert.Equal(t, big.NewInt(5), num)
+	assert.Equal(t, &counter{7}, count)
+
+	data, err := MarshalBinary(&count)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{7}, data)
+}
+
+func TestIsNil(t *testing.T) {
+	var ptr *int
+	var items []int
+	var err error
+	num := 0
+
+	assert.True(t, IsNil(&ptr))
+	assert.True(t, IsNil(&items))
+	assert.True(t, IsNil(&err))
+	assert.False(t, IsNil(&num))
+	assert.False(t, IsNil(&[]int{}))
+}
+
+func roundTripText[T any](it T, into *T) error {
+	data, err := MarshalText(&it)
+	if err != nil {
+		return err
+	}
+
+	return UnmarshalText(data, into)
+}
+
+func roundTripBinary[T any](it T, into *T) error {
+	data, err := MarshalBinary(&it)
+	if err != nil {
+		return err
+	}
+
+	return UnmarshalBinary(data, into)
+}
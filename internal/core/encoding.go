@@ -0,0 +1,117 @@
+package core
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/MisterKaiou/go-functional/internal/codec"
+)
+
+var (
+	// ErrInvalidText is returned when decoding text that was not produced by Result.MarshalText.
+	ErrInvalidText = errors.New(`result: text must start with "ok:" or "error:"`)
+	// ErrInvalidBinary is returned when decoding binary data that was not produced by Result.MarshalBinary.
+	ErrInvalidBinary = errors.New("result: invalid binary encoding")
+)
+
+var (
+	textOkPrefix    = []byte("ok:")
+	textErrorPrefix = []byte("error:")
+)
+
+const (
+	binaryOk byte = iota
+	binaryError
+	binaryOkNil
+)
+
+// MarshalText encodes Ok as "ok:" followed by the text encoding of its inner value, and Error as "error:" followed by
+// error.Error(). The inner value is encoded with its encoding.TextMarshaler implementation if it has one; strings,
+// booleans and numbers are formatted with strconv.
+func (r Result[T]) MarshalText() ([]byte, error) {
+	if r.IsError() {
+		return append(bytes.Clone(textErrorPrefix), r.err.Error()...), nil
+	}
+
+	data, err := codec.MarshalText(&r.ok)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(bytes.Clone(textOkPrefix), data...), nil
+}
+
+// UnmarshalText decodes the format produced by MarshalText. Decoded errors only keep their message, so they are
+// recreated with errors.New.
+func (r *Result[T]) UnmarshalText(data []byte) error {
+	if msg, found := bytes.CutPrefix(data, textErrorPrefix); found {
+		*r = Error[T](errors.New(string(msg)))
+		return nil
+	}
+
+	text, found := bytes.CutPrefix(data, textOkPrefix)
+	if !found {
+		return ErrInvalidText
+	}
+
+	var it T
+	if err := codec.UnmarshalText(text, &it); err != nil {
+		return err
+	}
+
+	*r = Ok(it)
+	return nil
+}
+
+// MarshalBinary encodes this Result as a single byte telling whether it is Ok, Ok holding nil or Error, followed, in
+// the case of Ok, by the binary encoding of its inner value and, in the case of Error, by error.Error(). The inner
+// value is encoded with its encoding.BinaryMarshaler implementation if it has one, otherwise with encoding/gob.
+// Implementing encoding.BinaryMarshaler also makes Result usable with encoding/gob.
+func (r Result[T]) MarshalBinary() ([]byte, error) {
+	if r.IsError() {
+		return append([]byte{binaryError}, r.err.Error()...), nil
+	}
+
+	if codec.IsNil(&r.ok) {
+		return []byte{binaryOkNil}, nil
+	}
+
+	data, err := codec.MarshalBinary(&r.ok)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{binaryOk}, data...), nil
+}
+
+// UnmarshalBinary decodes the format produced by MarshalBinary. Decoded errors only keep their message, so they are
+// recreated with errors.New.
+func (r *Result[T]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return ErrInvalidBinary
+	}
+
+	switch data[0] {
+	case binaryOk:
+		var it T
+		if err := codec.UnmarshalBinary(data[1:], &it); err != nil {
+			return err
+		}
+
+		*r = Ok(it)
+		return nil
+	case binaryOkNil:
+		if len(data) != 1 {
+			return ErrInvalidBinary
+		}
+
+		var it T
+		*r = Ok(it)
+		return nil
+	case binaryError:
+		*r = Error[T](errors.New(string(data[1:])))
+		return nil
+	}
+
+	return ErrInvalidBinary
+}
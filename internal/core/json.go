@@ -0,0 +1,56 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidJSON is returned when decoding a JSON value that is neither {"ok": ...} nor {"error": "..."}.
+var ErrInvalidJSON = errors.New(`result: JSON must be an object with exactly one of "ok" or "error"`)
+
+type jsonResult struct {
+	Ok    json.RawMessage `json:"ok,omitempty"`
+	Error *string         `json:"error,omitempty"`
+}
+
+// MarshalJSON encodes Ok as {"ok": value} and Error as {"error": "message"}, where message is the result of
+// error.Error().
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	if r.IsError() {
+		msg := r.err.Error()
+		return json.Marshal(jsonResult{Error: &msg})
+	}
+
+	ok, err := json.Marshal(&r.ok)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(jsonResult{Ok: ok})
+}
+
+// UnmarshalJSON decodes the format produced by MarshalJSON. Decoded errors only keep their message, so they are
+// recreated with errors.New.
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	var raw jsonResult
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if (raw.Ok == nil) == (raw.Error == nil) {
+		return ErrInvalidJSON
+	}
+
+	if raw.Error != nil {
+		*r = Error[T](errors.New(*raw.Error))
+		return nil
+	}
+
+	var it T
+	if err := json.Unmarshal(raw.Ok, &it); err != nil {
+		return err
+	}
+
+	*r = Ok(it)
+	return nil
+}
@@ -0,0 +1,92 @@
+// Package core holds the definition of result.Of, so that it can be referenced by the option package without an
+// import cycle. The result package exposes it as an alias, along with every function that operates on it.
+package core
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// ErrNilError is the error held by a Result created with a nil error. An error result always carries a non-nil error.
+var ErrNilError = errors.New("result: error result created with a nil error")
+
+// Result represents a result that can be either an input of given type, or error. It is exposed as result.Of.
+//
+// The zero value of Result is Ok holding the zero value of its type.
+type Result[Ok any] struct {
+	ok  Ok
+	err error
+}
+
+// Ok creates a new Result representing an Ok state.
+func Ok[Ok any](it Ok) Result[Ok] {
+	return Result[Ok]{
+		ok:  it,
+		err: nil,
+	}
+}
+
+// Error create a new Result that represents an Error state. If err is nil, the returned Result holds ErrNilError
+// instead.
+func Error[Ok any](err error) Result[Ok] {
+	if err == nil {
+		err = ErrNilError
+	}
+
+	return Result[Ok]{
+		err: err,
+	}
+}
+
+// Unpack returns the inner value and error of the given Result, without panicking. It is meant for the packages of
+// this module that need to read a Result without going through its methods.
+func Unpack[Ok any](r Result[Ok]) (Ok, error) {
+	return r.ok, r.err
+}
+
+// The value returned when calling this method depends on the state it represents. If ok return fmt.String applied to
+// its internal value; if error, return error.Error()
+func (r *Result[Ok]) String() string {
+	if r.IsError() {
+		return r.err.Error()
+	}
+
+	return fmt.Sprint(r.ok)
+}
+
+func (r *Result[Ok]) IsOk() bool {
+	return r.err == nil
+}
+
+func (r *Result[Ok]) IsError() bool {
+	return !r.IsOk()
+}
+
+// Unwrap can panic if this Result is an error. Prefer Match over this
+func (r *Result[Ok]) Unwrap() Ok {
+	if r.IsError() {
+		panic("cannot get the value of an error")
+	}
+
+	return r.ok
+}
+
+// UnwrapError can panic if this Result is not an error.
+func (r *Result[Ok]) UnwrapError() error {
+	if r.IsOk() {
+		panic("cannot get the error of an sucessful result")
+	}
+
+	return r.err
+}
+
+// All returns an iterator that yields the inner value of this Result if it is Ok, and nothing if it is an error.
+func (r *Result[Ok]) All() iter.Seq[Ok] {
+	res := *r
+	return func(yield func(Ok) bool) {
+		if res.IsOk() {
+			yield(res.ok)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+// Package list provides a persistent singly linked list. Operations never change an existing list: they return a new
+// one that shares as much of its structure with the old one as it can.
+package list
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/MisterKaiou/go-functional/option"
+)
+
+// Of represents an immutable singly linked list of values of type T. Adding to the front with Cons takes constant
+// time and shares the whole list it is given.
+//
+// The zero value of Of is an empty list.
+type Of[T any] struct {
+	node *node[T]
+}
+
+type node[T any] struct {
+	head T
+	tail *node[T]
+	len  int
+}
+
+// Empty creates a new empty Of.
+func Empty[T any]() Of[T] {
+	return Of[T]{}
+}
+
+// New creates a new Of holding the given items, in order.
+func New[T any](items ...T) Of[T] {
+	return FromSlice(items)
+}
+
+// FromSlice creates a new Of holding the items of the given slice, in order. The slice is not retained.
+func FromSlice[T any](items []T) Of[T] {
+	l := Empty[T]()
+	for i := len(items) - 1; i >= 0; i-- {
+		l = Cons(items[i], l)
+	}
+
+	return l
+}
+
+// Cons creates a new Of with head in front of the given list, which is shared, not copied.
+func Cons[T any](head T, l Of[T]) Of[T] {
+	return Of[T]{node: &node[T]{head: head, tail: l.node, len: l.Len() + 1}}
+}
+
+// Len returns the number of values in this Of. It takes constant time.
+func (l Of[T]) Len() int {
+	if l.node == nil {
+		return 0
+	}
+
+	return l.node.len
+}
+
+// IsEmpty returns whether this Of has no values.
+func (l Of[T]) IsEmpty() bool {
+	return l.node == nil
+}
+
+// The value returned when calling this method is "[a b c]", where each value is formatted with fmt.Sprint.
+func (l Of[T]) String() string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	first := true
+	for it := range l.All() {
+		if !first {
+			sb.WriteByte(' ')
+		}
+
+		first = false
+
+		sb.WriteString(fmt.Sprint(it))
+	}
+
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// All returns an iterator that yields the values of this Of, from head to tail.
+func (l Of[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := l.node; n != nil; n = n.tail {
+			if !yield(n.head) {
+				return
+			}
+		}
+	}
+}
+
+// Head returns Some with the first value of the given Of, or None if it is empty.
+func Head[T any](l Of[T]) option.Of[T] {
+	if l.node == nil {
+		return option.None[T]()
+	}
+
+	return option.Some(l.node.head)
+}
+
+// Tail returns Some with every value of the given Of but the first, or None if it is empty. The returned list is shared,
+// not copied.
+func Tail[T any](l Of[T]) option.Of[Of[T]] {
+	if l.node == nil {
+		return option.None[Of[T]]()
+	}
+
+	return option.Some(Of[T]{node: l.node.tail})
+}
+
+// ToSlice returns the values of the given Of in a new slice, from head to tail.
+func ToSlice[T any](l Of[T]) []T {
+	items := make([]T, 0, l.Len())
+	for it := range l.All() {
+		items = append(items, it)
+	}
+
+	return items
+}
+
+// Map creates a new Of holding the result of applying the mapping function to every value of the given one, in order.
+func Map[T, To any](l Of[T], mapping func(T) To) Of[To] {
+	mapped := make([]To, 0, l.Len())
+	for it := range l.All() {
+		mapped = append(mapped, mapping(it))
+	}
+
+	return FromSlice(mapped)
+}
+
+// Filter creates a new Of holding only the values of the given one that satisfy the predicate, in order. The values
+// after the last one that does not satisfy it are shared, not copied.
+func Filter[T any](l Of[T], predicate func(T) bool) Of[T] {
+	var kept []T
+	shared := l.node
+	for n := l.node; n != nil; n = n.tail {
+		if !predicate(n.head) {
+			for m := shared; m != n; m = m.tail {
+				kept = append(kept, m.head)
+			}
+
+			shared = n.tail
+		}
+	}
+
+	filtered := Of[T]{node: shared}
+	for i := len(kept) - 1; i >= 0; i-- {
+		filtered = Cons(kept[i], filtered)
+	}
+
+	return filtered
+}
+
+// FoldLeft applies the folder function to every value of the given Of, from head to tail, threading the state through.
+// It returns the final state.
+func FoldLeft[T, State any](l Of[T], state State, folder func(State, T) State) State {
+	for it := range l.All() {
+		state = folder(state, it)
+	}
+
+	return state
+}
+
+// FoldRight applies the folder function to every value of the given Of, from tail to head, threading the state
+// through. It returns the final state.
+func FoldRight[T, State any](l Of[T], state State, folder func(T, State) State) State {
+	items := ToSlice(l)
+	for i := len(items) - 1; i >= 0; i-- {
+		state = folder(items[i], state)
+	}
+
+	return state
+}
+
+// Reverse creates a new Of holding the values of the given one in reverse order.
+func Reverse[T any](l Of[T]) Of[T] {
+	return FoldLeft(l, Empty[T](), func(reversed Of[T], it T) Of[T] { return Cons(it, reversed) })
+}
+
+// Append creates a new Of holding the values of a followed by the values of b. The values of a are copied, and b is
+// shared.
+func Append[T any](a Of[T], b Of[T]) Of[T] {
+	return FoldRight(a, b, Cons[T])
+}
@@ -0,0 +1,141 @@
+package list
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/MisterKaiou/go-functional/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZeroValue(t *testing.T) {
+	var l Of[int]
+
+	assert.True(t, l.IsEmpty())
+	assert.Equal(t, 0, l.Len())
+	assert.Equal(t, option.None[int](), Head(l))
+	assert.Equal(t, option.None[Of[int]](), Tail(l))
+	assert.Equal(t, "[]", l.String())
+}
+
+func TestConsHeadTail(t *testing.T) {
+	tail := New(2, 3)
+
+	l := Cons(1, tail)
+
+	assert.Equal(t, option.Some(1), Head(l))
+	assert.Equal(t, option.Some(tail), Tail(l))
+	assert.Equal(t, 3, l.Len())
+	assert.Equal(t, []int{2, 3}, ToSlice(tail))
+	assert.Equal(t, "[1 2 3]", l.String())
+}
+
+func TestStringWithEmptyValues(t *testing.T) {
+	assert.Equal(t, fmt.Sprint([]string{"", "a"}), New("", "a").String())
+	assert.Equal(t, fmt.Sprint([]string{"", ""}), New("", "").String())
+	assert.Equal(t, fmt.Sprint([]string{""}), New("").String())
+}
+
+func TestFoldRight(t *testing.T) {
+	folded := FoldRight(New("a", "b", "c"), "", func(it string, acc string) string { return acc + it })
+
+	assert.Equal(t, "cba", folded)
+}
+
+func TestAppendSharesSecondList(t *testing.T) {
+	a, b := New(1, 2), New(3, 4)
+
+	appended := Append(a, b)
+
+	assert.Equal(t, []int{1, 2, 3, 4}, ToSlice(appended))
+	assert.Same(t, b.node, appended.node.tail.tail)
+	assert.Equal(t, []int{1, 2}, ToSlice(a))
+}
+
+func TestFilterSharesSuffix(t *testing.T) {
+	l := New(1, 2, 3, 4)
+
+	filtered := Filter(l, func(it int) bool { return it != 2 })
+
+	assert.Equal(t, []int{1, 3, 4}, ToSlice(filtered))
+	assert.Same(t, l.node.tail.tail, filtered.node.tail)
+	assert.Same(t, l.node, Filter(l, func(int) bool { return true }).node)
+}
+
+func TestAllEarlyStop(t *testing.T) {
+	var seen []int
+
+	for it := range New(1, 2, 3).All() {
+		seen = append(seen, it)
+		if it == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{1, 2}, seen)
+}
+
+// TestAgainstSliceModel applies random operations to lists and checks every one of them, including the lists it was
+// built from, against the same operations applied to slices.
+func TestAgainstSliceModel(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	lists := []Of[int]{Empty[int]()}
+	models := [][]int{{}}
+
+	for range 2000 {
+		i := rng.Intn(len(lists))
+		l, model := lists[i], models[i]
+
+		var next Of[int]
+		var expected []int
+		switch rng.Intn(7) {
+		case 0:
+			it := rng.Intn(100)
+			next, expected = Cons(it, l), append([]int{it}, model...)
+		case 1:
+			if l.IsEmpty() {
+				continue
+			}
+			next, expected = option.DefaultValue(Tail(l), Empty[int]()), slices.Clone(model[1:])
+		case 2:
+			next, expected = Map(l, func(it int) int { return it * 3 }), []int{}
+			for _, it := range model {
+				expected = append(expected, it*3)
+			}
+		case 3:
+			even := func(it int) bool { return it%2 == 0 }
+			next, expected = Filter(l, even), []int{}
+			for _, it := range model {
+				if even(it) {
+					expected = append(expected, it)
+				}
+			}
+		case 4:
+			expected = slices.Clone(model)
+			slices.Reverse(expected)
+			next = Reverse(l)
+		case 5:
+			j := rng.Intn(len(lists))
+			next, expected = Append(l, lists[j]), append(slices.Clone(model), models[j]...)
+		case 6:
+			sum := 0
+			for _, it := range model {
+				sum = sum*31 + it
+			}
+			assert.Equal(t, sum, FoldLeft(l, 0, func(acc int, it int) int { return acc*31 + it }))
+			continue
+		}
+
+		lists, models = append(lists, next), append(models, expected)
+	}
+
+	for i, l := range lists {
+		assert.Equal(t, models[i], ToSlice(l))
+		assert.Equal(t, len(models[i]), l.Len())
+		if len(models[i]) > 0 {
+			assert.Equal(t, option.Some(models[i][0]), Head(l))
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package option
+
+import "github.com/MisterKaiou/go-functional/tuple"
+
+// Apply applies the function held by fn on the value held by opt if both are Some, and returns it wrapped in a new Of.
+// Otherwise, returns None.
+func Apply[T, To any](fn Of[func(T) To], opt Of[T]) Of[To] {
+	if fn.IsNone() || opt.IsNone() {
+		return None[To]()
+	}
+
+	return Some(fn.some(opt.some))
+}
+
+// Lift turns a function on plain values into a function on Of, that returns None when given None.
+func Lift[T, To any](f func(T) To) func(Of[T]) Of[To] {
+	return func(opt Of[T]) Of[To] { return Map(opt, f) }
+}
+
+// Lift2 turns a function of two plain values into a function of two Of, that returns None when given any None.
+func Lift2[T1, T2, To any](f func(T1, T2) To) func(Of[T1], Of[T2]) Of[To] {
+	return func(o1 Of[T1], o2 Of[T2]) Of[To] { return Map2(o1, o2, f) }
+}
+
+// Map2 applies the mapping function on the inner values of the given Of, in the same order, if all of them are Some.
+// Otherwise, returns None.
+func Map2[T1, T2, To any](o1 Of[T1], o2 Of[T2], mapping func(T1, T2) To) Of[To] {
+	if o1.IsNone() || o2.IsNone() {
+		return None[To]()
+	}
+
+	return Some(mapping(o1.some, o2.some))
+}
+
+// Map3 applies the mapping function on the inner values of the given Of, in the same order, if all of them are Some.
+// Otherwise, returns None.
+func Map3[T1, T2, T3, To any](o1 Of[T1], o2 Of[T2], o3 Of[T3], mapping func(T1, T2, T3) To) Of[To] {
+	if o1.IsNone() || o2.IsNone() || o3.IsNone() {
+		return None[To]()
+	}
+
+	return Some(mapping(o1.some, o2.some, o3.some))
+}
+
+// Map4 applies the mapping function on the inner values of the given Of, in the same order, if all of them are Some.
+// Otherwise, returns None.
+func Map4[T1, T2, T3, T4, To any](
+	o1 Of[T1], o2 Of[T2], o3 Of[T3], o4 Of[T4], mapping func(T1, T2, T3, T4) To) Of[To] {
+	if o1.IsNone() || o2.IsNone() || o3.IsNone() || o4.IsNone() {
+		return None[To]()
+	}
+
+	return Some(mapping(o1.some, o2.some, o3.some, o4.some))
+}
+
+// Map5 applies the mapping function on the inner values of the given Of, in the same order, if all of them are Some.
+// Otherwise, returns None.
+func Map5[T1, T2, T3, T4, T5, To any](
+	o1 Of[T1], o2 Of[T2], o3 Of[T3], o4 Of[T4], o5 Of[T5], mapping func(T1, T2, T3, T4, T5) To) Of[To] {
+	if o1.IsNone() || o2.IsNone() || o3.IsNone() || o4.IsNone() || o5.IsNone() {
+		return None[To]()
+	}
+
+	return Some(mapping(o1.some, o2.some, o3.some, o4.some, o5.some))
+}
+
+// Map6 applies the mapping function on the inner values of the given Of, in the same order, if all of them are Some.
+// Otherwise, returns None.
+func Map6[T1, T2, T3, T4, T5, T6, To any](
+	o1 Of[T1], o2 Of[T2], o3 Of[T3], o4 Of[T4], o5 Of[T5], o6 Of[T6], mapping func(T1, T2, T3, T4, T5, T6) To) Of[To] {
+	if o1.IsNone() || o2.IsNone() || o3.IsNone() || o4.IsNone() || o5.IsNone() || o6.IsNone() {
+		return None[To]()
+	}
+
+	return Some(mapping(o1.some, o2.some, o3.some, o4.some, o5.some, o6.some))
+}
+
+// ComposeK returns a function that applies f to its argument and, if it returns Some, binds its inner value to g.
+// It composes functions meant for Bind from left to right.
+func ComposeK[A, B, C any](f func(A) Of[B], g func(B) Of[C]) func(A) Of[C] {
+	return func(it A) Of[C] { return Bind(f(it), g) }
+}
+
+// Zip returns Some holding a tuple.Pair with the inner values of both Of if both are Some. Otherwise, returns None.
+func Zip[A, B any](a Of[A], b Of[B]) Of[tuple.Pair[A, B]] {
+	return Map2(a, b, tuple.NewPair[A, B])
+}
+
+// Unzip splits an Of holding a tuple.Pair into an Of for each of its values. Both are None if the given Of is None.
+func Unzip[A, B any](opt Of[tuple.Pair[A, B]]) (Of[A], Of[B]) {
+	return Map(opt, tuple.Pair[A, B].First), Map(opt, tuple.Pair[A, B].Second)
+}
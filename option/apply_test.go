@@ -0,0 +1,105 @@
+package option
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MisterKaiou/go-functional/tuple"
+	"github.com/stretchr/testify/assert"
+)
+
+type address struct {
+	Street  string
+	Number  int
+	City    string
+	Country string
+	Zip     string
+	Primary bool
+}
+
+func TestApply(t *testing.T) {
+	double := Some(func(it int) int { return it * 2 })
+
+	assert.Equal(t, Some(84), Apply(double, Some(42)))
+	assert.Equal(t, None[int](), Apply(double, None[int]()))
+	assert.Equal(t, None[int](), Apply(None[func(int) int](), Some(42)))
+}
+
+func TestLift(t *testing.T) {
+	toString := Lift(func(it int) string { return fmt.Sprint(it) })
+
+	assert.Equal(t, Some("42"), toString(Some(42)))
+	assert.Equal(t, None[string](), toString(None[int]()))
+}
+
+func TestLift2(t *testing.T) {
+	sub := Lift2(func(a, b int) int { return a - b })
+
+	assert.Equal(t, Some(1), sub(Some(3), Some(2)))
+	assert.Equal(t, None[int](), sub(None[int](), Some(2)))
+}
+
+func TestMap2(t *testing.T) {
+	pair := func(s string, i int) string { return fmt.Sprint(s, i) }
+
+	assert.Equal(t, Some("a1"), Map2(Some("a"), Some(1), pair))
+	assert.Equal(t, None[string](), Map2(None[string](), Some(1), pair))
+	assert.Equal(t, None[string](), Map2(Some("a"), None[int](), pair))
+}
+
+func TestMap3(t *testing.T) {
+	f := func(s string, i int, c string) address { return address{Street: s, Number: i, City: c} }
+
+	assert.Equal(t, Some(address{Street: "Main", Number: 1, City: "Town"}), Map3(Some("Main"), Some(1), Some("Town"), f))
+	assert.Equal(t, None[address](), Map3(Some("Main"), Some(1), None[string](), f))
+}
+
+func TestMap4(t *testing.T) {
+	f := func(s string, i int, c, co string) address { return address{s, i, c, co, "", false} }
+
+	assert.Equal(t, Some(address{"Main", 1, "Town", "BR", "", false}), Map4(Some("Main"), Some(1), Some("Town"), Some("BR"), f))
+	assert.Equal(t, None[address](), Map4(Some("Main"), None[int](), Some("Town"), Some("BR"), f))
+}
+
+func TestMap5(t *testing.T) {
+	f := func(s string, i int, c, co, z string) address { return address{s, i, c, co, z, false} }
+
+	assert.Equal(t,
+		Some(address{"Main", 1, "Town", "BR", "000", false}),
+		Map5(Some("Main"), Some(1), Some("Town"), Some("BR"), Some("000"), f))
+	assert.Equal(t, None[address](), Map5(Some("Main"), Some(1), Some("Town"), Some("BR"), None[string](), f))
+}
+
+func TestMap6(t *testing.T) {
+	f := func(s string, i int, c, co, z string, p bool) address { return address{s, i, c, co, z, p} }
+
+	assert.Equal(t,
+		Some(address{"Main", 1, "Town", "BR", "000", true}),
+		Map6(Some("Main"), Some(1), Some("Town"), Some("BR"), Some("000"), Some(true), f))
+	assert.Equal(t, None[address](), Map6(None[string](), Some(1), Some("Town"), Some("BR"), Some("000"), Some(true), f))
+}
+
+func TestComposeK(t *testing.T) {
+	positive := func(it int) Of[int] { return Filter(Some(it), func(i int) bool { return i > 0 }) }
+	parsePositive := ComposeK(parse, positive)
+
+	assert.Equal(t, Some(42), parsePositive("42"))
+	assert.Equal(t, None[int](), parsePositive("-1"))
+	assert.Equal(t, None[int](), parsePositive("nope"))
+}
+
+func TestZip(t *testing.T) {
+	assert.Equal(t, Some(tuple.NewPair("a", 1)), Zip(Some("a"), Some(1)))
+	assert.Equal(t, None[tuple.Pair[string, int]](), Zip(None[string](), Some(1)))
+	assert.Equal(t, None[tuple.Pair[string, int]](), Zip(Some("a"), None[int]()))
+}
+
+func TestUnzip(t *testing.T) {
+	a, b := Unzip(Some(tuple.NewPair("a", 1)))
+	noneA, noneB := Unzip(None[tuple.Pair[string, int]]())
+
+	assert.Equal(t, Some("a"), a)
+	assert.Equal(t, Some(1), b)
+	assert.Equal(t, None[string](), noneA)
+	assert.Equal(t, None[int](), noneB)
+}
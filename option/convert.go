@@ -0,0 +1,40 @@
+package option
+
+import "github.com/MisterKaiou/go-functional/internal/core"
+
+// ToResult creates a result.Of from the given Of. If Some, the result is Ok with the inner value, else it is an error
+// holding err. The return type is result.Of; it is spelled core.Result because result.Of is an alias of it, shared to
+// avoid an import cycle between the two packages.
+func ToResult[T any](opt Of[T], err error) core.Result[T] {
+	if opt.IsNone() {
+		return core.Error[T](err)
+	}
+
+	return core.Ok(opt.some)
+}
+
+// ToResultWith works like ToResult, but the error is only created, by calling errorFn, if the given Of is None. The
+// return type is result.Of, spelled core.Result for the same reason as in ToResult.
+func ToResultWith[T any](opt Of[T], errorFn func() error) core.Result[T] {
+	if opt.IsNone() {
+		return core.Error[T](errorFn())
+	}
+
+	return core.Ok(opt.some)
+}
+
+// Transpose turns an Of of a result.Of into a result.Of of an Of. None becomes Ok holding None, Some holding Ok
+// becomes Ok holding Some, and Some holding an error becomes that error. Both the received and the returned core.Result
+// are result.Of, spelled this way for the same reason as in ToResult.
+func Transpose[T any](opt Of[core.Result[T]]) core.Result[Of[T]] {
+	if opt.IsNone() {
+		return core.Ok(None[T]())
+	}
+
+	it, err := core.Unpack(opt.some)
+	if err != nil {
+		return core.Error[Of[T]](err)
+	}
+
+	return core.Ok(Some(it))
+}
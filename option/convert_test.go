@@ -0,0 +1,39 @@
+package option
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MisterKaiou/go-functional/internal/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToResult(t *testing.T) {
+	err := errors.New("missing")
+
+	assert.Equal(t, core.Ok(42), ToResult(Some(42), err))
+	assert.Equal(t, core.Error[int](err), ToResult(None[int](), err))
+}
+
+func TestToResultWith(t *testing.T) {
+	err := errors.New("missing")
+	called := false
+	errorFn := func() error {
+		called = true
+		return err
+	}
+
+	assert.Equal(t, core.Ok(42), ToResultWith(Some(42), errorFn))
+	assert.False(t, called)
+
+	assert.Equal(t, core.Error[int](err), ToResultWith(None[int](), errorFn))
+	assert.True(t, called)
+}
+
+func TestTranspose(t *testing.T) {
+	err := errors.New("error")
+
+	assert.Equal(t, core.Ok(None[int]()), Transpose(None[core.Result[int]]()))
+	assert.Equal(t, core.Ok(Some(42)), Transpose(Some(core.Ok(42))))
+	assert.Equal(t, core.Error[Of[int]](err), Transpose(Some(core.Error[int](err))))
+}
@@ -0,0 +1,100 @@
+package option
+
+import (
+	"errors"
+
+	"github.com/MisterKaiou/go-functional/internal/codec"
+)
+
+// ErrInvalidBinary is returned when decoding binary data that was not produced by Of.MarshalBinary.
+var ErrInvalidBinary = errors.New("option: invalid binary encoding")
+
+const (
+	binaryNone byte = iota
+	binarySome
+	binarySomeNil
+)
+
+// MarshalText encodes None as empty text and Some as the text encoding of its inner value. The inner value is encoded
+// with its encoding.TextMarshaler implementation if it has one; strings, booleans and numbers are formatted with
+// strconv.
+func (o Of[T]) MarshalText() ([]byte, error) {
+	if o.IsNone() {
+		return []byte{}, nil
+	}
+
+	return codec.MarshalText(&o.some)
+}
+
+// UnmarshalText decodes empty text as None and anything else as Some, following the same rules as MarshalText. This
+// means a Some holding a value encoded as empty text, like an empty string, is decoded as None.
+func (o *Of[T]) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*o = None[T]()
+		return nil
+	}
+
+	var it T
+	if err := codec.UnmarshalText(data, &it); err != nil {
+		return err
+	}
+
+	*o = Some(it)
+	return nil
+}
+
+// MarshalBinary encodes this Of as a single byte telling whether it is None, Some or Some holding nil, followed, in
+// the case of Some, by the binary encoding of its inner value. The inner value is encoded with its
+// encoding.BinaryMarshaler implementation if it has one, otherwise with encoding/gob. Implementing
+// encoding.BinaryMarshaler also makes Of usable with encoding/gob.
+func (o Of[T]) MarshalBinary() ([]byte, error) {
+	if o.IsNone() {
+		return []byte{binaryNone}, nil
+	}
+
+	if codec.IsNil(&o.some) {
+		return []byte{binarySomeNil}, nil
+	}
+
+	data, err := codec.MarshalBinary(&o.some)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{binarySome}, data...), nil
+}
+
+// UnmarshalBinary decodes the format produced by MarshalBinary.
+func (o *Of[T]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return ErrInvalidBinary
+	}
+
+	switch data[0] {
+	case binaryNone:
+		if len(data) != 1 {
+			return ErrInvalidBinary
+		}
+
+		*o = None[T]()
+		return nil
+	case binarySome:
+		var it T
+		if err := codec.UnmarshalBinary(data[1:], &it); err != nil {
+			return err
+		}
+
+		*o = Some(it)
+		return nil
+	case binarySomeNil:
+		if len(data) != 1 {
+			return ErrInvalidBinary
+		}
+
+		var it T
+		*o = Some(it)
+		return nil
+	}
+
+	return ErrInvalidBinary
+}
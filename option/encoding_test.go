@@ -0,0 +1,211 @@
+package option
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"flag"
+	"math/big"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalText(t *testing.T) {
+	cases := []struct {
+		opt      interface{ MarshalText() ([]byte, error) }
+		expected string
+	}{
+		{None[int](), ""},
+		{Some(42), "42"},
+		{Some[uint8](7), "7"},
+		{Some(-1.5), "-1.5"},
+		{Some(true), "true"},
+		{Some("hello"), "hello"},
+		{Some(netip.MustParseAddr("127.0.0.1")), "127.0.0.1"},
+	}
+
+	for _, c := range cases {
+		data, err := c.opt.MarshalText()
+
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, string(data))
+	}
+}
+
+func TestMarshalTextUnsupported(t *testing.T) {
+	_, err := Some([]int{1}).MarshalText()
+
+	assert.Error(t, err)
+}
+
+func TestUnmarshalText(t *testing.T) {
+	var num Of[int]
+	var duration Of[time.Duration]
+	var addr Of[netip.Addr]
+	var empty Of[string]
+
+	assert.NoError(t, num.UnmarshalText([]byte("42")))
+	assert.NoError(t, duration.UnmarshalText([]byte("42")))
+	assert.NoError(t, addr.UnmarshalText([]byte("::1")))
+	assert.NoError(t, empty.UnmarshalText([]byte("")))
+
+	assert.Equal(t, Some(42), num)
+	assert.Equal(t, Some[time.Duration](42), duration)
+	assert.Equal(t, Some(netip.IPv6Loopback()), addr)
+	assert.Equal(t, None[string](), empty)
+}
+
+func TestUnmarshalTextInvalid(t *testing.T) {
+	opt := Some[int8](1)
+
+	err := opt.UnmarshalText([]byte("1000"))
+
+	assert.Error(t, err)
+	assert.Equal(t, Some[int8](1), opt)
+}
+
+func TestTextFlag(t *testing.T) {
+	var port Of[int]
+	var host Of[string]
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.TextVar(&port, "port", None[int](), "")
+	flags.TextVar(&host, "host", None[string](), "")
+
+	err := flags.Parse([]string{"-port", "8080"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, Some(8080), port)
+	assert.Equal(t, None[string](), host)
+}
+
+func TestTextMapKey(t *testing.T) {
+	data, err := json.Marshal(map[Of[string]]int{Some("a"): 1, None[string](): 0})
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"a":1,"":0}`, string(data))
+
+	var decoded map[Of[string]]int
+	err = json.Unmarshal(data, &decoded)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[Of[string]]int{Some("a"): 1, None[string](): 0}, decoded)
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	var num Of[int]
+	var nested Of[Of[string]]
+	var addr Of[netip.Addr]
+	var none Of[Of[string]]
+
+	assert.NoError(t, roundTripBinary(Some(42), &num))
+	assert.NoError(t, roundTripBinary(Some(Some("inner")), &nested))
+	assert.NoError(t, roundTripBinary(Some(netip.IPv6Loopback()), &addr))
+	assert.NoError(t, roundTripBinary(Some(None[string]()), &none))
+
+	assert.Equal(t, Some(42), num)
+	assert.Equal(t, Some(Some("inner")), nested)
+	assert.Equal(t, Some(netip.IPv6Loopback()), addr)
+	assert.Equal(t, Some(None[string]()), none)
+}
+
+type location struct {
+	Street string
+	Number int
+}
+
+func TestBigIntRoundTrip(t *testing.T) {
+	var text Of[*big.Int]
+	var binary Of[*big.Int]
+
+	data, err := Some(big.NewInt(5)).MarshalText()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "5", string(data))
+	assert.NoError(t, text.UnmarshalText(data))
+	assert.NoError(t, roundTripBinary(Some(big.NewInt(5)), &binary))
+
+	assert.Equal(t, Some(big.NewInt(5)), text)
+	assert.Equal(t, Some(big.NewInt(5)), binary)
+}
+
+func TestBinaryRoundTripNil(t *testing.T) {
+	var ptr Of[*location]
+	var items Of[[]int]
+	var err Of[error]
+
+	assert.NoError(t, roundTripBinary(Some[*location](nil), &ptr))
+	assert.NoError(t, roundTripBinary(Some[[]int](nil), &items))
+	assert.NoError(t, roundTripBinary(Some[error](nil), &err))
+
+	assert.Equal(t, Some[*location](nil), ptr)
+	assert.Equal(t, Some[[]int](nil), items)
+	assert.Equal(t, Some[error](nil), err)
+}
+
+func TestBinaryRoundTripPointer(t *testing.T) {
+	var ptr Of[*location]
+
+	assert.NoError(t, roundTripBinary(Some(&location{"street", 42}), &ptr))
+
+	assert.Equal(t, Some(&location{"street", 42}), ptr)
+}
+
+func TestUnmarshalBinaryInvalid(t *testing.T) {
+	var opt Of[int]
+
+	assert.ErrorIs(t, opt.UnmarshalBinary(nil), ErrInvalidBinary)
+	assert.ErrorIs(t, opt.UnmarshalBinary([]byte{binaryNone, 1}), ErrInvalidBinary)
+	assert.ErrorIs(t, opt.UnmarshalBinary([]byte{binarySomeNil, 1}), ErrInvalidBinary)
+	assert.ErrorIs(t, opt.UnmarshalBinary([]byte{42}), ErrInvalidBinary)
+	assert.Error(t, opt.UnmarshalBinary([]byte{binarySome, 1, 2, 3}))
+}
+
+type cacheEntry struct {
+	Key   string
+	Value Of[[]int]
+	TTL   Of[time.Duration]
+}
+
+func TestGob(t *testing.T) {
+	expected := cacheEntry{Key: "key", Value: Some([]int{1, 2, 3}), TTL: None[time.Duration]()}
+	var buf bytes.Buffer
+	var decoded cacheEntry
+
+	err := gob.NewEncoder(&buf).Encode(expected)
+	assert.NoError(t, err)
+
+	err = gob.NewDecoder(&buf).Decode(&decoded)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, decoded)
+}
+
+func TestGobNil(t *testing.T) {
+	type profile struct {
+		Name    string
+		Address Of[*location]
+	}
+	expected := profile{Name: "name", Address: Some[*location](nil)}
+	var buf bytes.Buffer
+	var decoded profile
+
+	err := gob.NewEncoder(&buf).Encode(expected)
+	assert.NoError(t, err)
+
+	err = gob.NewDecoder(&buf).Decode(&decoded)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, decoded)
+}
+
+func roundTripBinary[T any](opt Of[T], into *Of[T]) error {
+	data, err := opt.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	return into.UnmarshalBinary(data)
+}
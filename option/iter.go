@@ -0,0 +1,40 @@
+package option
+
+import "iter"
+
+// All returns an iterator that yields the inner value of this Of if it is Some, and nothing if it is None.
+func (o *Of[T]) All() iter.Seq[T] {
+	return All(*o)
+}
+
+// All returns an iterator that yields the inner value of the given Of if it is Some, and nothing if it is None.
+func All[T any](opt Of[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if opt.IsSome() {
+			yield(opt.some)
+		}
+	}
+}
+
+// Collect returns Some with the first value yielded by seq, or None if it yields nothing. seq is not consumed past its
+// first value.
+func Collect[T any](seq iter.Seq[T]) Of[T] {
+	for it := range seq {
+		return Some(it)
+	}
+
+	return None[T]()
+}
+
+// FilterMap returns an iterator that applies the mapping function to every value yielded by seq and yields the inner
+// value of the ones that are Some.
+func FilterMap[T, To any](seq iter.Seq[T], mapping func(T) Of[To]) iter.Seq[To] {
+	return func(yield func(To) bool) {
+		for it := range seq {
+			mapped := mapping(it)
+			if mapped.IsSome() && !yield(mapped.some) {
+				return
+			}
+		}
+	}
+}
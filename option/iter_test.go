@@ -0,0 +1,78 @@
+package option
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllSome(t *testing.T) {
+	opt := Some(42)
+	var yielded []int
+
+	for it := range opt.All() {
+		yielded = append(yielded, it)
+	}
+
+	assert.Equal(t, []int{42}, yielded)
+}
+
+func TestAllNone(t *testing.T) {
+	assert.Empty(t, slices.Collect(All(None[int]())))
+}
+
+func TestAllSomeNil(t *testing.T) {
+	assert.Equal(t, []error{nil}, slices.Collect(All(Some[error](nil))))
+}
+
+func TestCollect(t *testing.T) {
+	assert.Equal(t, Some(1), Collect(slices.Values([]int{1, 2, 3})))
+	assert.Equal(t, None[int](), Collect(slices.Values([]int{})))
+}
+
+func TestCollectStopsAfterFirst(t *testing.T) {
+	pulled := 0
+	seq := func(yield func(int) bool) {
+		for i := 0; i < 3; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	assert.Equal(t, Some(0), Collect(seq))
+	assert.Equal(t, 1, pulled)
+}
+
+func TestFilterMap(t *testing.T) {
+	parse := func(s string) Of[int] {
+		it, err := strconv.Atoi(s)
+		if err != nil {
+			return None[int]()
+		}
+
+		return Some(it)
+	}
+
+	parsed := FilterMap(slices.Values([]string{"1", "two", "3"}), parse)
+
+	assert.Equal(t, []int{1, 3}, slices.Collect(parsed))
+}
+
+func TestFilterMapEarlyStop(t *testing.T) {
+	calls := 0
+	mapped := FilterMap(maps.Keys(map[int]bool{1: true, 2: true, 3: true}), func(it int) Of[int] {
+		calls++
+		return Some(it)
+	})
+
+	for range mapped {
+		break
+	}
+
+	assert.Equal(t, 1, calls)
+}
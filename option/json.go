@@ -0,0 +1,41 @@
+package option
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+var jsonNull = []byte("null")
+
+// MarshalJSON encodes None as null and Some as the encoding of its inner value.
+//
+// Since None is null, a Some holding something that is itself encoded as null (like a nil pointer or a None) cannot be
+// told apart from None once encoded.
+func (o Of[T]) MarshalJSON() ([]byte, error) {
+	if o.IsNone() {
+		return jsonNull, nil
+	}
+
+	return json.Marshal(&o.some)
+}
+
+// UnmarshalJSON decodes null as None and anything else as Some holding the decoded value.
+func (o *Of[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		*o = None[T]()
+		return nil
+	}
+
+	var it T
+	if err := json.Unmarshal(data, &it); err != nil {
+		return err
+	}
+
+	*o = Some(it)
+	return nil
+}
+
+// IsZero reports whether this Of is None. It allows fields tagged with omitzero to be omitted when None.
+func (o Of[T]) IsZero() bool {
+	return o.IsNone()
+}
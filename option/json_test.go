@@ -0,0 +1,127 @@
+package option
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalJSONSome(t *testing.T) {
+	data, err := json.Marshal(Some(42))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "42", string(data))
+}
+
+func TestMarshalJSONNone(t *testing.T) {
+	data, err := json.Marshal(None[int]())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+}
+
+func TestUnmarshalJSONSome(t *testing.T) {
+	var opt Of[string]
+
+	err := json.Unmarshal([]byte(`"hello"`), &opt)
+
+	assert.NoError(t, err)
+	assert.Equal(t, Some("hello"), opt)
+}
+
+func TestUnmarshalJSONNone(t *testing.T) {
+	opt := Some(42)
+
+	err := json.Unmarshal([]byte(`null`), &opt)
+
+	assert.NoError(t, err)
+	assert.Equal(t, None[int](), opt)
+}
+
+func TestUnmarshalJSONInvalid(t *testing.T) {
+	var opt Of[int]
+
+	err := json.Unmarshal([]byte(`"not a number"`), &opt)
+
+	assert.Error(t, err)
+	assert.True(t, opt.IsNone())
+}
+
+func TestJSONRoundTripNested(t *testing.T) {
+	cases := []Of[Of[int]]{Some(Some(42)), None[Of[int]]()}
+
+	for _, expected := range cases {
+		var decoded Of[Of[int]]
+		data, err := json.Marshal(expected)
+		assert.NoError(t, err)
+
+		err = json.Unmarshal(data, &decoded)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, decoded)
+	}
+}
+
+func TestJSONNestedSomeNoneDecodesAsNone(t *testing.T) {
+	var decoded Of[Of[int]]
+	data, _ := json.Marshal(Some(None[int]()))
+
+	err := json.Unmarshal(data, &decoded)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+	assert.True(t, decoded.IsNone())
+}
+
+type user struct {
+	Name     string      `json:"name"`
+	Nickname Of[string]  `json:"nickname"`
+	Age      Of[int]     `json:"age,omitzero"`
+	Email    Of[string]  `json:"email,omitempty"`
+	Tags     Of[[]int]   `json:"tags,omitzero"`
+	Manager  *Of[string] `json:"manager,omitempty"`
+}
+
+func TestJSONStructFields(t *testing.T) {
+	expected := user{
+		Name:     "Kaiou",
+		Nickname: None[string](),
+		Age:      None[int](),
+		Email:    None[string](),
+		Tags:     Some[[]int](nil),
+	}
+
+	data, err := json.Marshal(expected)
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Kaiou","nickname":null,"email":null,"tags":null}`, string(data))
+
+	var decoded user
+	err = json.Unmarshal(data, &decoded)
+
+	assert.NoError(t, err)
+	assert.Equal(t, None[int](), decoded.Age)
+	assert.Equal(t, None[string](), decoded.Nickname)
+	assert.Nil(t, decoded.Manager)
+}
+
+func TestJSONOmitZeroKeepsSome(t *testing.T) {
+	data, err := json.Marshal(user{Age: Some(0)})
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"","nickname":null,"age":0,"email":null}`, string(data))
+}
+
+type custom struct{ A int }
+
+func (c *custom) MarshalJSON() ([]byte, error) {
+	return []byte(`"custom"`), nil
+}
+
+func TestMarshalJSONPointerReceiver(t *testing.T) {
+	data, err := json.Marshal(struct{ F Of[custom] }{Some(custom{})})
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"F":"custom"}`, string(data))
+}
@@ -5,15 +5,14 @@ import (
 	"github.com/MisterKaiou/go-functional/unit"
 )
 
-type some[Of any] any
-
 // Of represents a value that can be either something or nothing.
 type Of[T any] struct {
-	some some[T]
+	some   T
+	isSome bool
 }
 
 func (o *Of[T]) IsSome() bool {
-	return o.some != nil
+	return o.isSome
 }
 
 func IsSome[T any](o Of[T]) bool {
@@ -44,7 +43,7 @@ func (o *Of[T]) Unwrap() T {
 		panic("cannot get the value of nothing (none)")
 	}
 
-	return o.some.(T)
+	return o.some
 }
 
 // Map applies the mapping function on the option's internal value if it is Some, and returns a new Of.
@@ -53,7 +52,7 @@ func Map[T any, To any](opt Of[T], mapping func(T) To) Of[To] {
 		return None[To]()
 	}
 
-	return Some(mapping(opt.some.(T)))
+	return Some(mapping(opt.some))
 }
 
 // Bind accepts a function that takes the Of internal value and returns another Of
@@ -62,7 +61,7 @@ func Bind[T any, To any](opt Of[T], binding func(T) Of[To]) Of[To] {
 		return None[To]()
 	}
 
-	return binding(opt.some.(T))
+	return binding(opt.some)
 }
 
 // Match accepts two functions that return a value of the same type, but the first one receives the value stored in
@@ -72,13 +71,14 @@ func Match[T any, To any](opt Of[T], ok func(T) To, failed func() To) To {
 		return failed()
 	}
 
-	return ok(opt.some.(T))
+	return ok(opt.some)
 }
 
 // Some creates a new Of representing Some state
 func Some[T any](it T) Of[T] {
 	return Of[T]{
-		some: it,
+		some:   it,
+		isSome: true,
 	}
 }
 
@@ -93,7 +93,7 @@ func Contains[T comparable](opt Of[T], expected T) bool {
 		return false
 	}
 
-	return opt.some.(T) == expected
+	return opt.some == expected
 }
 
 // DefaultValue returns the inner value of this Of or the provided default value.
@@ -102,7 +102,7 @@ func DefaultValue[T any](opt Of[T], or T) T {
 		return or
 	}
 
-	return opt.some.(T)
+	return opt.some
 }
 
 // DefaultWith returns the inner value of this Of or executes the provided function
@@ -111,7 +111,7 @@ func DefaultWith[T any](opt Of[T], def func() T) T {
 		return def()
 	}
 
-	return opt.some.(T)
+	return opt.some
 }
 
 // Exists tests the Of inner value against the given predicate.
@@ -120,7 +120,7 @@ func Exists[T any](opt Of[T], predicate func(T) bool) bool {
 		return false
 	}
 
-	return predicate(opt.some.(T))
+	return predicate(opt.some)
 }
 
 // Filter returns Some if the Of inner value satisfies the condition, else returns None
@@ -129,7 +129,7 @@ func Filter[T any](opt Of[T], predicate func(T) bool) Of[T] {
 		return opt
 	}
 
-	if !predicate(opt.some.(T)) {
+	if !predicate(opt.some) {
 		return None[T]()
 	}
 
@@ -142,7 +142,7 @@ func Fold[T, State any](opt Of[T], state State, folder func(State, T) State) Sta
 		return state
 	}
 
-	return folder(state, opt.some.(T))
+	return folder(state, opt.some)
 }
 
 // FoldTo applies the folder function passing the provided state and the Of inner value and returns a new value from it.
@@ -151,7 +151,7 @@ func FoldTo[T, State, To any](opt Of[T], state State, folder func(State, T) To)
 		return None[To]()
 	}
 
-	return Some(folder(state, opt.some.(T)))
+	return Some(folder(state, opt.some))
 }
 
 // FoldM applies the folder function, passing the provided state and the Of inner value to it and returns State
@@ -161,10 +161,11 @@ func FoldM[T, State any](opt Of[T], state State, folder func(State, T) State) Of
 		return None[State]()
 	}
 
-	return Some(folder(state, opt.some.(T)))
+	return Some(folder(state, opt.some))
 }
 
-// CombineBy applies the combiner function on State and the current Result by unwrapping them.
+// CombineBy applies the combiner function on State and the current Result by unwrapping them. Note that the combiner
+// receives the value of state first; prefer Map2, which passes the values in the same order as its arguments.
 func CombineBy[It, With, To any](opt Of[It], state Of[With], combiner func(With, It) To) Of[To] {
 	if opt.IsNone() {
 		return None[To]()
@@ -174,7 +175,7 @@ func CombineBy[It, With, To any](opt Of[It], state Of[With], combiner func(With,
 		return None[To]()
 	}
 
-	return Some(combiner(state.some.(With), opt.some.(It)))
+	return Some(combiner(state.some, opt.some))
 }
 
 // Flatten returns a Result from a Result of Result.
@@ -183,7 +184,7 @@ func Flatten[T any](opt Of[Of[T]]) Of[T] {
 		return None[T]()
 	}
 
-	return opt.some.(Of[T])
+	return opt.some
 }
 
 // Iter applies the given action to the inner value of the Of provided.
@@ -192,5 +193,5 @@ func Iter[T any](opt Of[T], action func(it T) unit.Unit) unit.Unit {
 		return unit.Unit{}
 	}
 
-	return action(opt.some.(T))
+	return action(opt.some)
 }
@@ -1,6 +1,7 @@
 package option
 
 import (
+	"errors"
 	"fmt"
 	"github.com/MisterKaiou/go-functional/unit"
 	"github.com/stretchr/testify/assert"
@@ -22,7 +23,8 @@ func TestStringNone(t *testing.T) {
 func TestNone(t *testing.T) {
 	opt := None[unit.Unit]()
 
-	assert.Nil(t, opt.some)
+	assert.Zero(t, opt.some)
+	assert.False(t, opt.isSome)
 }
 
 func TestIsNone(t *testing.T) {
@@ -71,7 +73,8 @@ func TestMapNone(t *testing.T) {
 
 	mappedOpt := Map(opt, func(it bool) string { return fmt.Sprint(it) })
 
-	assert.Nil(t, mappedOpt.some)
+	assert.Zero(t, mappedOpt.some)
+	assert.False(t, mappedOpt.isSome)
 }
 
 func TestBindSome(t *testing.T) {
@@ -88,7 +91,8 @@ func TestBindNone(t *testing.T) {
 
 	boundRes := Bind(opt, func(val int) Of[string] { return Some(fmt.Sprint(val)) })
 
-	assert.Nil(t, boundRes.some)
+	assert.Zero(t, boundRes.some)
+	assert.False(t, boundRes.isSome)
 }
 
 func TestMatchSome(t *testing.T) {
@@ -214,7 +218,7 @@ func TestCombineByNoError(t *testing.T) {
 	})
 
 	assert.True(t, combined.IsSome())
-	assert.True(t, combined.some.(bool))
+	assert.True(t, combined.some)
 }
 
 func TestCombineByWithError(t *testing.T) {
@@ -228,7 +232,8 @@ func TestCombineByWithError(t *testing.T) {
 	combined := CombineBy(left, right, combiningFunc)
 
 	assert.True(t, combined.IsNone())
-	assert.Nil(t, combined.some)
+	assert.Zero(t, combined.some)
+	assert.False(t, combined.isSome)
 
 	left = Some(69)
 	right = None[string]()
@@ -236,7 +241,8 @@ func TestCombineByWithError(t *testing.T) {
 	combined = CombineBy(left, right, combiningFunc)
 
 	assert.True(t, combined.IsNone())
-	assert.Nil(t, combined.some)
+	assert.Zero(t, combined.some)
+	assert.False(t, combined.isSome)
 
 }
 
@@ -273,12 +279,80 @@ func TestIter(t *testing.T) {
 
 	Iter(opt, incrementPtr)
 
-	assert.Equal(t, expected, *(opt.some.(*int)))
+	assert.Equal(t, expected, *opt.some)
 	assert.Same(t, &value, opt.some)
 
 	Iter(none, incrementPtr)
 
-	assert.Nil(t, none.some)
+	assert.Zero(t, none.some)
+	assert.False(t, none.isSome)
+}
+
+func TestSomeNilPointer(t *testing.T) {
+	opt := Some[*int](nil)
+
+	assert.True(t, opt.IsSome())
+	assert.Nil(t, opt.Unwrap())
+	assert.Equal(t, "<nil>", opt.String())
+}
+
+func TestSomeNilInterface(t *testing.T) {
+	opt := Some[error](nil)
+
+	assert.True(t, opt.IsSome())
+	assert.Nil(t, opt.Unwrap())
+	assert.Equal(t, "<nil>", opt.String())
+}
+
+func TestSomeNilSlice(t *testing.T) {
+	opt := Some[[]int](nil)
+
+	assert.True(t, opt.IsSome())
+	assert.Nil(t, opt.Unwrap())
+	assert.Equal(t, "[]", opt.String())
+}
+
+func TestSomeNilMap(t *testing.T) {
+	opt := Some[map[string]int](nil)
+
+	assert.True(t, opt.IsSome())
+	assert.Nil(t, opt.Unwrap())
+	assert.Equal(t, "map[]", opt.String())
+}
+
+func TestSomeNilFunc(t *testing.T) {
+	opt := Some[func()](nil)
+
+	assert.True(t, opt.IsSome())
+	assert.Nil(t, opt.Unwrap())
+}
+
+func TestSomeNilPreservedThroughFunctions(t *testing.T) {
+	opt := Some[error](nil)
+	isNil := func(err error) bool { return err == nil }
+
+	assert.True(t, IsSome(Map(opt, func(err error) error { return err })))
+	assert.True(t, IsSome(Bind(opt, func(err error) Of[error] { return Some(err) })))
+	assert.True(t, IsSome(Filter(opt, isNil)))
+	assert.True(t, Exists(opt, isNil))
+	assert.True(t, Contains(Some[*int](nil), nil))
+	assert.Nil(t, DefaultValue(opt, errors.New("default")))
+	assert.Nil(t, DefaultWith(opt, func() error { return errors.New("default") }))
+	assert.True(t, Match(opt, isNil, func() bool { return false }))
+	assert.True(t, IsSome(FoldM(opt, 0, func(s int, _ error) int { return s })))
+	assert.True(t, IsSome(CombineBy(opt, Some[[]int](nil), func(_ []int, err error) error { return err })))
+
+	flattened := Flatten(Some(opt))
+
+	assert.True(t, flattened.IsSome())
+	assert.Nil(t, flattened.Unwrap())
+}
+
+func TestZeroValueIsNone(t *testing.T) {
+	var opt Of[*int]
+
+	assert.True(t, opt.IsNone())
+	assert.Equal(t, None[*int](), opt)
 }
 
 func BenchmarkFoldVsMapVsFoldM(b *testing.B) {
@@ -309,3 +383,160 @@ func BenchmarkFoldVsMapVsFoldM(b *testing.B) {
 		}
 	})
 }
+
+type point struct{ x, y, z int }
+
+func TestZeroAllocs(t *testing.T) {
+	p := point{1, 2, 3}
+	var opt Of[point]
+	var matched bool
+
+	cases := map[string]func(){
+		"Some":  func() { opt = Some(p) },
+		"Map":   func() { opt = Map(Some(p), func(it point) point { return point{it.z, it.y, it.x} }) },
+		"Bind":  func() { opt = Bind(Some(p), Some[point]) },
+		"Match": func() { matched = Match(Some(p), func(point) bool { return true }, func() bool { return false }) },
+	}
+
+	for name, f := range cases {
+		assert.Zero(t, testing.AllocsPerRun(100, f), name)
+	}
+
+	assert.Equal(t, Some(p), opt)
+	assert.True(t, matched)
+}
+
+func BenchmarkFunctions(b *testing.B) {
+	p := point{1, 2, 3}
+	isOrigin := func(it point) bool { return it == point{} }
+
+	b.Run("Some", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Some(p)
+		}
+	})
+
+	b.Run("None", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			None[point]()
+		}
+	})
+
+	b.Run("IsSome", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			IsSome(Some(p))
+		}
+	})
+
+	b.Run("IsNone", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			IsNone(Some(p))
+		}
+	})
+
+	b.Run("Unwrap", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			some := Some(p)
+
+			some.Unwrap()
+		}
+	})
+
+	b.Run("String", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			some := Some(p)
+
+			_ = some.String()
+		}
+	})
+
+	b.Run("Map", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Map(Some(p), func(it point) point { it.x += i; return it })
+		}
+	})
+
+	b.Run("Bind", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Bind(Some(p), func(it point) Of[point] { it.x += i; return Some(it) })
+		}
+	})
+
+	b.Run("Match", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Match(Some(p), func(it point) int { return it.x + i }, func() int { return i })
+		}
+	})
+
+	b.Run("Contains", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Contains(Some(p), p)
+		}
+	})
+
+	b.Run("DefaultValue", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			DefaultValue(None[point](), p)
+		}
+	})
+
+	b.Run("DefaultWith", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			DefaultWith(None[point](), func() point { return p })
+		}
+	})
+
+	b.Run("Exists", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Exists(Some(p), isOrigin)
+		}
+	})
+
+	b.Run("Filter", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Filter(Some(p), isOrigin)
+		}
+	})
+
+	b.Run("FoldTo", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			FoldTo(Some(p), i, func(st int, it point) point { it.x += st; return it })
+		}
+	})
+
+	b.Run("CombineBy", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			CombineBy(Some(p), Some(i), func(st int, it point) point { it.x += st; return it })
+		}
+	})
+
+	b.Run("Flatten", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Flatten(Some(Some(p)))
+		}
+	})
+
+	b.Run("Iter", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Iter(Some(p), func(it point) unit.Unit { return unit.Unit{} })
+		}
+	})
+}
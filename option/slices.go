@@ -0,0 +1,20 @@
+package option
+
+// Choose applies the function to every element of the slice and returns the inner values of the ones that are Some, in
+// order.
+func Choose[T, To any](items []T, chooser func(T) Of[To]) []To {
+	var chosen []To
+	for _, it := range items {
+		opt := chooser(it)
+		if opt.IsSome() {
+			chosen = append(chosen, opt.some)
+		}
+	}
+
+	return chosen
+}
+
+// CatOptions returns the inner values of the elements of the slice that are Some, in order.
+func CatOptions[T any](items []Of[T]) []T {
+	return Choose(items, func(it Of[T]) Of[T] { return it })
+}
@@ -0,0 +1,18 @@
+package option
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChoose(t *testing.T) {
+	assert.Equal(t, []int{1, 3}, Choose([]string{"1", "two", "3"}, parse))
+	assert.Empty(t, Choose([]string{"one"}, parse))
+}
+
+func TestCatOptions(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, CatOptions([]Of[int]{Some(1), None[int](), Some(2)}))
+	assert.Equal(t, []*int{nil}, CatOptions([]Of[*int]{None[*int](), Some[*int](nil)}))
+	assert.Empty(t, CatOptions([]Of[int]{None[int]()}))
+}
@@ -0,0 +1,33 @@
+package option
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+// Scan implements sql.Scanner. A NULL column is scanned as None, anything else is converted to T following the same
+// rules as sql.Null and stored as Some.
+func (o *Of[T]) Scan(src any) error {
+	var null sql.Null[T]
+	if err := null.Scan(src); err != nil {
+		return err
+	}
+
+	if !null.Valid {
+		*o = None[T]()
+		return nil
+	}
+
+	*o = Some(null.V)
+	return nil
+}
+
+// Value implements driver.Valuer. None is written as NULL and Some as its inner value, converted by
+// driver.DefaultParameterConverter.
+func (o Of[T]) Value() (driver.Value, error) {
+	if o.IsNone() {
+		return nil, nil
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(o.some)
+}
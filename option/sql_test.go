@@ -0,0 +1,206 @@
+package option
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// echoDriver is a fake database/sql driver whose queries return a single row holding the arguments they received.
+type echoDriver struct{}
+
+type echoConn struct{}
+
+type echoStmt struct{}
+
+type echoRows struct {
+	values []driver.Value
+	done   bool
+}
+
+func (echoDriver) Open(string) (driver.Conn, error) { return echoConn{}, nil }
+
+func (echoConn) Prepare(string) (driver.Stmt, error) { return echoStmt{}, nil }
+func (echoConn) Close() error                        { return nil }
+func (echoConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func (echoStmt) Close() error                               { return nil }
+func (echoStmt) NumInput() int                              { return -1 }
+func (echoStmt) Exec([]driver.Value) (driver.Result, error) { return driver.ResultNoRows, nil }
+func (echoStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &echoRows{values: args}, nil
+}
+
+func (r *echoRows) Columns() []string {
+	columns := make([]string, len(r.values))
+	for i := range columns {
+		columns[i] = "value"
+	}
+
+	return columns
+}
+
+func (r *echoRows) Close() error { return nil }
+
+func (r *echoRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+
+	r.done = true
+	copy(dest, r.values)
+	return nil
+}
+
+func init() {
+	sql.Register("option-echo", echoDriver{})
+}
+
+func openEchoDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("option-echo", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+type upper string
+
+func (u *upper) Scan(src any) error {
+	s, ok := src.(string)
+	if !ok {
+		return errors.New("upper: expected a string")
+	}
+
+	*u = upper(strings.ToUpper(s))
+	return nil
+}
+
+func TestScanNull(t *testing.T) {
+	opt := Some("previous")
+
+	err := opt.Scan(nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, None[string](), opt)
+}
+
+func TestScanConversions(t *testing.T) {
+	now := time.Now()
+	var str Of[string]
+	var num Of[int]
+	var numStr Of[string]
+	var bytes Of[[]byte]
+	var date Of[time.Time]
+	var flag Of[bool]
+
+	assert.NoError(t, str.Scan([]byte("hello")))
+	assert.NoError(t, num.Scan(int64(42)))
+	assert.NoError(t, numStr.Scan(int64(42)))
+	assert.NoError(t, bytes.Scan("raw"))
+	assert.NoError(t, date.Scan(now))
+	assert.NoError(t, flag.Scan(int64(1)))
+
+	assert.Equal(t, Some("hello"), str)
+	assert.Equal(t, Some(42), num)
+	assert.Equal(t, Some("42"), numStr)
+	assert.Equal(t, Some([]byte("raw")), bytes)
+	assert.Equal(t, Some(now), date)
+	assert.Equal(t, Some(true), flag)
+}
+
+func TestScanInnerScanner(t *testing.T) {
+	var opt Of[upper]
+
+	err := opt.Scan("shout")
+
+	assert.NoError(t, err)
+	assert.Equal(t, Some[upper]("SHOUT"), opt)
+}
+
+func TestScanInvalid(t *testing.T) {
+	opt := Some(7)
+
+	err := opt.Scan("not a number")
+
+	assert.Error(t, err)
+	assert.Equal(t, Some(7), opt)
+}
+
+func TestValue(t *testing.T) {
+	cases := []struct {
+		valuer   driver.Valuer
+		expected driver.Value
+	}{
+		{None[int](), nil},
+		{Some(42), int64(42)},
+		{Some[int32](42), int64(42)},
+		{Some("hello"), "hello"},
+		{Some[*int](nil), nil},
+		{Some(Some(1.5)), 1.5},
+		{Some(None[string]()), nil},
+		{Some(sql.NullString{String: "null", Valid: true}), "null"},
+	}
+
+	for _, c := range cases {
+		value, err := c.valuer.Value()
+
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, value)
+	}
+}
+
+func TestValueInvalid(t *testing.T) {
+	_, err := Some(struct{}{}).Value()
+
+	assert.Error(t, err)
+}
+
+func TestDatabaseRoundTrip(t *testing.T) {
+	db := openEchoDB(t)
+	var name Of[string]
+	var age Of[int64]
+
+	err := db.QueryRow("SELECT ?, ?", Some("Kaiou"), None[int64]()).Scan(&name, &age)
+
+	assert.NoError(t, err)
+	assert.Equal(t, Some("Kaiou"), name)
+	assert.Equal(t, None[int64](), age)
+}
+
+func TestDatabaseFromNullTypes(t *testing.T) {
+	db := openEchoDB(t)
+	var str Of[string]
+	var num Of[int64]
+	var generic Of[float64]
+
+	err := db.QueryRow("SELECT ?, ?, ?",
+		sql.NullString{String: "value", Valid: true},
+		sql.NullInt64{},
+		sql.Null[float64]{V: 2.5, Valid: true}).Scan(&str, &num, &generic)
+
+	assert.NoError(t, err)
+	assert.Equal(t, Some("value"), str)
+	assert.Equal(t, None[int64](), num)
+	assert.Equal(t, Some(2.5), generic)
+}
+
+func TestDatabaseIntoNullTypes(t *testing.T) {
+	db := openEchoDB(t)
+	var str sql.NullString
+	var num sql.NullInt64
+
+	err := db.QueryRow("SELECT ?, ?", Some("value"), None[int]()).Scan(&str, &num)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sql.NullString{String: "value", Valid: true}, str)
+	assert.Equal(t, sql.NullInt64{}, num)
+}
@@ -0,0 +1,22 @@
+package option
+
+// Traverse applies the function to every element of the slice, in order, and returns Some with the values it produced.
+// It stops at the first None, returning None.
+func Traverse[T, To any](items []T, f func(T) Of[To]) Of[[]To] {
+	values := make([]To, 0, len(items))
+	for _, it := range items {
+		opt := f(it)
+		if opt.IsNone() {
+			return None[[]To]()
+		}
+
+		values = append(values, opt.some)
+	}
+
+	return Some(values)
+}
+
+// Sequence turns a slice of Of into an Of of a slice. It returns None if any of the elements is None.
+func Sequence[T any](items []Of[T]) Of[[]T] {
+	return Traverse(items, func(it Of[T]) Of[T] { return it })
+}
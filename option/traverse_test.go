@@ -0,0 +1,36 @@
+package option
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func parse(s string) Of[int] {
+	it, err := strconv.Atoi(s)
+	if err != nil {
+		return None[int]()
+	}
+
+	return Some(it)
+}
+
+func TestTraverse(t *testing.T) {
+	assert.Equal(t, Some([]int{1, 2, 3}), Traverse([]string{"1", "2", "3"}, parse))
+	assert.Equal(t, Some([]int{}), Traverse([]string{}, parse))
+}
+
+func TestTraverseWithNone(t *testing.T) {
+	calls := 0
+	counted := func(s string) Of[int] { calls++; return parse(s) }
+
+	assert.Equal(t, None[[]int](), Traverse([]string{"1", "two", "3"}, counted))
+	assert.Equal(t, 2, calls)
+}
+
+func TestSequence(t *testing.T) {
+	assert.Equal(t, Some([]int{1, 2}), Sequence([]Of[int]{Some(1), Some(2)}))
+	assert.Equal(t, None[[]int](), Sequence([]Of[int]{Some(1), None[int]()}))
+	assert.Equal(t, Some([]*int{nil}), Sequence([]Of[*int]{Some[*int](nil)}))
+}
@@ -0,0 +1,194 @@
+package pmap
+
+import "math/bits"
+
+const (
+	bitsPerLevel = 5
+	levelMask    = 1<<bitsPerLevel - 1
+)
+
+// node is a level of the trie. Each bit set in bitmap stands for a slot, and slots only holds the ones that are set, in
+// order, so a node never allocates room for missing children.
+type node[K comparable, V any] struct {
+	bitmap uint32
+	slots  []slot[K, V]
+}
+
+// slot holds either a child node or the entries whose keys have the given hash. Keys only share a bucket when their whole
+// hash collides, so buckets almost always hold a single entry.
+type slot[K comparable, V any] struct {
+	child  *node[K, V]
+	hash   uint64
+	bucket []entry[K, V]
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// position returns the bit that stands for hash at the level given by shift, and the index of its slot.
+func (n *node[K, V]) position(hash uint64, shift uint) (uint32, int) {
+	bit := uint32(1) << ((hash >> shift) & levelMask)
+	return bit, bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+func (n *node[K, V]) get(hash uint64, shift uint, key K) (V, bool) {
+	for n != nil {
+		bit, index := n.position(hash, shift)
+		if n.bitmap&bit == 0 {
+			break
+		}
+
+		s := &n.slots[index]
+		if s.child != nil {
+			n, shift = s.child, shift+bitsPerLevel
+			continue
+		}
+
+		if s.hash == hash {
+			for _, e := range s.bucket {
+				if e.key == key {
+					return e.value, true
+				}
+			}
+		}
+
+		break
+	}
+
+	var zero V
+	return zero, false
+}
+
+// set returns a copy of n, with key holding value, and whether key was added instead of replaced. Only the nodes in the
+// path to key are copied.
+func (n *node[K, V]) set(hash uint64, shift uint, key K, value V) (*node[K, V], bool) {
+	if n == nil {
+		n = &node[K, V]{}
+	}
+
+	bit, index := n.position(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n.insertSlot(bit, index, slot[K, V]{hash: hash, bucket: []entry[K, V]{{key, value}}}), true
+	}
+
+	s := n.slots[index]
+	switch {
+	case s.child != nil:
+		child, added := s.child.set(hash, shift+bitsPerLevel, key, value)
+		return n.replaceSlot(index, slot[K, V]{child: child}), added
+	case s.hash == hash:
+		for i, e := range s.bucket {
+			if e.key == key {
+				bucket := append([]entry[K, V](nil), s.bucket...)
+				bucket[i].value = value
+				return n.replaceSlot(index, slot[K, V]{hash: hash, bucket: bucket}), false
+			}
+		}
+
+		bucket := append(append([]entry[K, V](nil), s.bucket...), entry[K, V]{key, value})
+		return n.replaceSlot(index, slot[K, V]{hash: hash, bucket: bucket}), true
+	default:
+		childBit, _ := (&node[K, V]{}).position(s.hash, shift+bitsPerLevel)
+		child := &node[K, V]{bitmap: childBit, slots: []slot[K, V]{s}}
+		child, _ = child.set(hash, shift+bitsPerLevel, key, value)
+		return n.replaceSlot(index, slot[K, V]{child: child}), true
+	}
+}
+
+// delete returns a copy of n without key, and whether key was there. It returns nil if the copy would be empty. A child
+// left with a single bucket is pulled up into its parent, so the trie stays as shallow as the keys allow.
+func (n *node[K, V]) delete(hash uint64, shift uint, key K) (*node[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	bit, index := n.position(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+
+	s := n.slots[index]
+	if s.child != nil {
+		child, removed := s.child.delete(hash, shift+bitsPerLevel, key)
+		switch {
+		case !removed:
+			return n, false
+		case child == nil:
+			return n.removeSlot(bit, index), true
+		case len(child.slots) == 1 && child.slots[0].child == nil:
+			return n.replaceSlot(index, child.slots[0]), true
+		default:
+			return n.replaceSlot(index, slot[K, V]{child: child}), true
+		}
+	}
+
+	if s.hash != hash {
+		return n, false
+	}
+
+	for i, e := range s.bucket {
+		if e.key != key {
+			continue
+		}
+
+		if len(s.bucket) == 1 {
+			return n.removeSlot(bit, index), true
+		}
+
+		bucket := append(append([]entry[K, V](nil), s.bucket[:i]...), s.bucket[i+1:]...)
+		return n.replaceSlot(index, slot[K, V]{hash: hash, bucket: bucket}), true
+	}
+
+	return n, false
+}
+
+// each calls f with every entry under n until it returns false, and returns whether it never did.
+func (n *node[K, V]) each(f func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	for _, s := range n.slots {
+		if s.child != nil {
+			if !s.child.each(f) {
+				return false
+			}
+
+			continue
+		}
+
+		for _, e := range s.bucket {
+			if !f(e.key, e.value) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func (n *node[K, V]) insertSlot(bit uint32, index int, s slot[K, V]) *node[K, V] {
+	slots := make([]slot[K, V], len(n.slots)+1)
+	copy(slots, n.slots[:index])
+	slots[index] = s
+	copy(slots[index+1:], n.slots[index:])
+	return &node[K, V]{bitmap: n.bitmap | bit, slots: slots}
+}
+
+func (n *node[K, V]) replaceSlot(index int, s slot[K, V]) *node[K, V] {
+	slots := append([]slot[K, V](nil), n.slots...)
+	slots[index] = s
+	return &node[K, V]{bitmap: n.bitmap, slots: slots}
+}
+
+func (n *node[K, V]) removeSlot(bit uint32, index int) *node[K, V] {
+	if len(n.slots) == 1 {
+		return nil
+	}
+
+	slots := make([]slot[K, V], 0, len(n.slots)-1)
+	slots = append(append(slots, n.slots[:index]...), n.slots[index+1:]...)
+	return &node[K, V]{bitmap: n.bitmap &^ bit, slots: slots}
+}
@@ -0,0 +1,159 @@
+// Package pmap provides a persistent hash map, implemented as a hash array mapped trie. Updates never change an
+// existing map: they return a new one that shares every part of the trie they did not touch.
+package pmap
+
+import (
+	"hash/maphash"
+	"iter"
+
+	"github.com/MisterKaiou/go-functional/option"
+)
+
+var seed = maphash.MakeSeed()
+
+// Of represents an immutable map from keys of type K to values of type V. Getting, setting and deleting a key take
+// time proportional to the depth of the trie, which grows with the logarithm, in base 32, of its length.
+//
+// Like a built-in map, the order in which an Of is iterated is not specified. The zero value of Of is an empty map.
+type Of[K comparable, V any] struct {
+	root *node[K, V]
+	len  int
+}
+
+// Empty creates a new empty Of.
+func Empty[K comparable, V any]() Of[K, V] {
+	return Of[K, V]{}
+}
+
+// FromMap creates a new Of holding the entries of the given map. The map is not retained.
+func FromMap[K comparable, V any](m map[K]V) Of[K, V] {
+	p := Empty[K, V]()
+	for key, value := range m {
+		p = Set(p, key, value)
+	}
+
+	return p
+}
+
+// Len returns the number of entries in this Of. It takes constant time.
+func (m Of[K, V]) Len() int {
+	return m.len
+}
+
+// All returns an iterator that yields the entries of this Of.
+func (m Of[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.root.each(yield)
+	}
+}
+
+// Keys returns an iterator that yields the keys of this Of.
+func (m Of[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		m.root.each(func(key K, _ V) bool { return yield(key) })
+	}
+}
+
+// Values returns an iterator that yields the values of this Of.
+func (m Of[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		m.root.each(func(_ K, value V) bool { return yield(value) })
+	}
+}
+
+// Get returns Some with the value of key in the given Of, or None if it has no such key.
+func Get[K comparable, V any](m Of[K, V], key K) option.Of[V] {
+	value, ok := m.root.get(hashOf(key), 0, key)
+	if !ok {
+		return option.None[V]()
+	}
+
+	return option.Some(value)
+}
+
+// Contains returns whether the given Of has key.
+func Contains[K comparable, V any](m Of[K, V], key K) bool {
+	_, ok := m.root.get(hashOf(key), 0, key)
+	return ok
+}
+
+// Set returns a new Of where key holds value. The given Of is left untouched.
+func Set[K comparable, V any](m Of[K, V], key K, value V) Of[K, V] {
+	return m.set(hashOf(key), key, value)
+}
+
+// Delete returns a new Of without key. The given Of is left untouched, and returned as it is if it has no such key.
+func Delete[K comparable, V any](m Of[K, V], key K) Of[K, V] {
+	return m.delete(hashOf(key), key)
+}
+
+// Merge returns a new Of holding the entries of a and b. If both have a key, the value in b is kept.
+func Merge[K comparable, V any](a Of[K, V], b Of[K, V]) Of[K, V] {
+	return MergeWith(a, b, func(_ K, _ V, it V) V { return it })
+}
+
+// MergeWith returns a new Of holding the entries of a and b. If both have a key, its value is the one returned by
+// resolve, which receives the key and its values in a and b, in that order.
+func MergeWith[K comparable, V any](a Of[K, V], b Of[K, V], resolve func(K, V, V) V) Of[K, V] {
+	return Fold(b, a, func(merged Of[K, V], key K, value V) Of[K, V] {
+		hash := hashOf(key)
+		if current, ok := merged.root.get(hash, 0, key); ok {
+			value = resolve(key, current, value)
+		}
+
+		return merged.set(hash, key, value)
+	})
+}
+
+// Filter returns a new Of holding only the entries of the given one that satisfy the predicate.
+func Filter[K comparable, V any](m Of[K, V], predicate func(K, V) bool) Of[K, V] {
+	return Fold(m, m, func(filtered Of[K, V], key K, value V) Of[K, V] {
+		if predicate(key, value) {
+			return filtered
+		}
+
+		return Delete(filtered, key)
+	})
+}
+
+// Fold applies the folder function to every entry of the given Of, threading the state through. It returns the final
+// state.
+func Fold[K comparable, V, State any](m Of[K, V], state State, folder func(State, K, V) State) State {
+	for key, value := range m.All() {
+		state = folder(state, key, value)
+	}
+
+	return state
+}
+
+// ToMap returns the entries of the given Of in a new built-in map.
+func ToMap[K comparable, V any](m Of[K, V]) map[K]V {
+	out := make(map[K]V, m.len)
+	for key, value := range m.All() {
+		out[key] = value
+	}
+
+	return out
+}
+
+func (m Of[K, V]) set(hash uint64, key K, value V) Of[K, V] {
+	root, added := m.root.set(hash, 0, key, value)
+	if added {
+		return Of[K, V]{root: root, len: m.len + 1}
+	}
+
+	return Of[K, V]{root: root, len: m.len}
+}
+
+func (m Of[K, V]) delete(hash uint64, key K) Of[K, V] {
+	root, removed := m.root.delete(hash, 0, key)
+	if !removed {
+		return m
+	}
+
+	return Of[K, V]{root: root, len: m.len - 1}
+}
+
+func hashOf[K comparable](key K) uint64 {
+	return maphash.Comparable(seed, key)
+}
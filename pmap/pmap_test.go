@@ -0,0 +1,205 @@
+package pmap
+
+import (
+	"fmt"
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/MisterKaiou/go-functional/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZeroValue(t *testing.T) {
+	var m Of[string, int]
+
+	assert.Equal(t, 0, m.Len())
+	assert.Equal(t, option.None[int](), Get(m, "a"))
+	assert.Equal(t, m, Delete(m, "a"))
+	assert.Empty(t, ToMap(m))
+}
+
+func TestSetIsPersistent(t *testing.T) {
+	m := FromMap(map[string]int{"a": 1, "b": 2})
+
+	updated := Set(Set(m, "a", 10), "c", 3)
+
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, ToMap(m))
+	assert.Equal(t, map[string]int{"a": 10, "b": 2, "c": 3}, ToMap(updated))
+	assert.Equal(t, 3, updated.Len())
+	assert.Equal(t, option.Some(10), Get(updated, "a"))
+	assert.True(t, Contains(updated, "c"))
+	assert.False(t, Contains(m, "c"))
+}
+
+func TestDeleteIsPersistent(t *testing.T) {
+	m := FromMap(map[string]int{"a": 1, "b": 2})
+
+	deleted := Delete(m, "a")
+
+	assert.Equal(t, map[string]int{"b": 2}, ToMap(deleted))
+	assert.Equal(t, 2, m.Len())
+	assert.Equal(t, 1, deleted.Len())
+	assert.Same(t, m.root, Delete(m, "missing").root)
+}
+
+func TestHashCollisions(t *testing.T) {
+	m := Empty[string, int]()
+
+	m = m.set(7, "a", 1)
+	m = m.set(7, "b", 2)
+	m = m.set(7|1<<60, "c", 3)
+	m = m.set(7, "a", 10)
+
+	assert.Equal(t, 3, m.Len())
+	assert.Equal(t, map[string]int{"a": 10, "b": 2, "c": 3}, ToMap(m))
+
+	value, ok := m.root.get(7, 0, "b")
+	assert.True(t, ok)
+	assert.Equal(t, 2, value)
+
+	_, ok = m.root.get(7, 0, "c")
+	assert.False(t, ok)
+
+	m = m.delete(7, "a")
+	m = m.delete(7, "c")
+	m = m.delete(7|1<<60, "c")
+
+	assert.Equal(t, map[string]int{"b": 2}, ToMap(m))
+	assert.Nil(t, m.root.slots[0].child)
+
+	m = m.delete(7, "b")
+
+	assert.Nil(t, m.root)
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestMerge(t *testing.T) {
+	a := FromMap(map[string]int{"a": 1, "b": 2})
+	b := FromMap(map[string]int{"b": 20, "c": 30})
+
+	assert.Equal(t, map[string]int{"a": 1, "b": 20, "c": 30}, ToMap(Merge(a, b)))
+
+	summed := MergeWith(a, b, func(_ string, x int, y int) int { return x + y })
+	assert.Equal(t, map[string]int{"a": 1, "b": 22, "c": 30}, ToMap(summed))
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, ToMap(a))
+}
+
+func TestFilter(t *testing.T) {
+	m := FromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	odd := Filter(m, func(_ string, it int) bool { return it%2 == 1 })
+
+	assert.Equal(t, map[string]int{"a": 1, "c": 3}, ToMap(odd))
+	assert.Equal(t, 3, m.Len())
+}
+
+func TestFold(t *testing.T) {
+	m := FromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	sum := Fold(m, 0, func(sum int, _ string, it int) int { return sum + it })
+
+	assert.Equal(t, 6, sum)
+}
+
+func TestIteration(t *testing.T) {
+	m := FromMap(map[int]string{1: "a", 2: "b", 3: "c"})
+
+	assert.ElementsMatch(t, []int{1, 2, 3}, slices.Collect(m.Keys()))
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, slices.Collect(m.Values()))
+
+	seen := 0
+	for range m.All() {
+		seen++
+		break
+	}
+
+	assert.Equal(t, 1, seen)
+}
+
+// TestAgainstBuiltinMap applies random updates to a few versions of an Of and checks all of them against built-in maps
+// that received the same updates.
+func TestAgainstBuiltinMap(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	versions := []Of[int, int]{Empty[int, int]()}
+	models := []map[int]int{{}}
+
+	for range 5000 {
+		i := rng.Intn(len(versions))
+		key := rng.Intn(2000)
+
+		var next Of[int, int]
+		expected := maps.Clone(models[i])
+		if rng.Intn(3) == 0 {
+			next = Delete(versions[i], key)
+			delete(expected, key)
+		} else {
+			value := rng.Int()
+			next = Set(versions[i], key, value)
+			expected[key] = value
+		}
+
+		if len(versions) < 50 {
+			versions, models = append(versions, next), append(models, expected)
+		} else {
+			versions[i], models[i] = next, expected
+		}
+	}
+
+	for i, m := range versions {
+		assert.Equal(t, models[i], ToMap(m))
+		assert.Equal(t, len(models[i]), m.Len())
+		for key, value := range models[i] {
+			assert.Equal(t, option.Some(value), Get(m, key))
+		}
+	}
+}
+
+func BenchmarkSetVsCopy(b *testing.B) {
+	for _, size := range []int{10, 1000, 100000} {
+		builtin := make(map[int]int, size)
+		for i := range size {
+			builtin[i] = i
+		}
+		persistent := FromMap(builtin)
+
+		b.Run(fmt.Sprint("pmap/", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				Set(persistent, i%size, i)
+			}
+		})
+
+		b.Run(fmt.Sprint("map copy/", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				updated := maps.Clone(builtin)
+				updated[i%size] = i
+			}
+		})
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	const size = 100000
+	builtin := make(map[int]int, size)
+	for i := range size {
+		builtin[i] = i
+	}
+	persistent := FromMap(builtin)
+
+	b.Run("pmap", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Get(persistent, i%size)
+		}
+	})
+
+	b.Run("map", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = builtin[i%size]
+		}
+	})
+}
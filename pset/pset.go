@@ -0,0 +1,79 @@
+// Package pset provides a persistent hash set, backed by the hash array mapped trie of the pmap package. Updates never
+// change an existing set: they return a new one that shares every part of the trie they did not touch.
+package pset
+
+import (
+	"iter"
+
+	"github.com/MisterKaiou/go-functional/pmap"
+	"github.com/MisterKaiou/go-functional/unit"
+)
+
+// Of represents an immutable set of values of type T. Like a built-in map, the order in which it is iterated is not
+// specified.
+//
+// The zero value of Of is an empty set.
+type Of[T comparable] struct {
+	items pmap.Of[T, unit.Unit]
+}
+
+// Empty creates a new empty Of.
+func Empty[T comparable]() Of[T] {
+	return Of[T]{}
+}
+
+// New creates a new Of holding the given items.
+func New[T comparable](items ...T) Of[T] {
+	s := Empty[T]()
+	for _, it := range items {
+		s = Add(s, it)
+	}
+
+	return s
+}
+
+// Len returns the number of values in this Of. It takes constant time.
+func (s Of[T]) Len() int {
+	return s.items.Len()
+}
+
+// All returns an iterator that yields the values of this Of.
+func (s Of[T]) All() iter.Seq[T] {
+	return s.items.Keys()
+}
+
+// Contains returns whether the given Of holds it.
+func Contains[T comparable](s Of[T], it T) bool {
+	return pmap.Contains(s.items, it)
+}
+
+// Add returns a new Of that also holds it. The given Of is left untouched.
+func Add[T comparable](s Of[T], it T) Of[T] {
+	return Of[T]{items: pmap.Set(s.items, it, unit.Unit{})}
+}
+
+// Remove returns a new Of without it. The given Of is left untouched.
+func Remove[T comparable](s Of[T], it T) Of[T] {
+	return Of[T]{items: pmap.Delete(s.items, it)}
+}
+
+// Merge returns a new Of holding the values of both a and b.
+func Merge[T comparable](a Of[T], b Of[T]) Of[T] {
+	return Of[T]{items: pmap.Merge(a.items, b.items)}
+}
+
+// Filter returns a new Of holding only the values of the given one that satisfy the predicate.
+func Filter[T comparable](s Of[T], predicate func(T) bool) Of[T] {
+	return Of[T]{items: pmap.Filter(s.items, func(it T, _ unit.Unit) bool { return predicate(it) })}
+}
+
+// Fold applies the folder function to every value of the given Of, threading the state through. It returns the final
+// state.
+func Fold[T comparable, State any](s Of[T], state State, folder func(State, T) State) State {
+	return pmap.Fold(s.items, state, func(state State, it T, _ unit.Unit) State { return folder(state, it) })
+}
+
+// ToSlice returns the values of the given Of in a new slice.
+func ToSlice[T comparable](s Of[T]) []T {
+	return Fold(s, make([]T, 0, s.Len()), func(items []T, it T) []T { return append(items, it) })
+}
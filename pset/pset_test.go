@@ -0,0 +1,115 @@
+package pset
+
+import (
+	"fmt"
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZeroValue(t *testing.T) {
+	var s Of[int]
+
+	assert.Equal(t, 0, s.Len())
+	assert.False(t, Contains(s, 0))
+	assert.Empty(t, ToSlice(s))
+}
+
+func TestAddIsPersistent(t *testing.T) {
+	s := New(1, 2)
+
+	added := Add(Add(s, 3), 1)
+
+	assert.ElementsMatch(t, []int{1, 2}, ToSlice(s))
+	assert.ElementsMatch(t, []int{1, 2, 3}, ToSlice(added))
+	assert.Equal(t, 3, added.Len())
+	assert.True(t, Contains(added, 3))
+	assert.False(t, Contains(s, 3))
+}
+
+func TestRemoveIsPersistent(t *testing.T) {
+	s := New(1, 2)
+
+	removed := Remove(s, 1)
+
+	assert.ElementsMatch(t, []int{2}, ToSlice(removed))
+	assert.Equal(t, 2, s.Len())
+	assert.Equal(t, s, Remove(s, 42))
+}
+
+func TestMerge(t *testing.T) {
+	merged := Merge(New(1, 2), New(2, 3))
+
+	assert.ElementsMatch(t, []int{1, 2, 3}, slices.Collect(merged.All()))
+}
+
+func TestFilter(t *testing.T) {
+	odd := Filter(New(1, 2, 3), func(it int) bool { return it%2 == 1 })
+
+	assert.ElementsMatch(t, []int{1, 3}, ToSlice(odd))
+}
+
+func TestFold(t *testing.T) {
+	assert.Equal(t, 6, Fold(New(1, 2, 3), 0, func(sum int, it int) int { return sum + it }))
+}
+
+// TestAgainstBuiltinMap applies random updates to a few versions of an Of and checks all of them against built-in maps
+// that received the same updates.
+func TestAgainstBuiltinMap(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	versions := []Of[int]{Empty[int]()}
+	models := []map[int]bool{{}}
+
+	for range 3000 {
+		i := rng.Intn(len(versions))
+		it := rng.Intn(1000)
+
+		var next Of[int]
+		expected := maps.Clone(models[i])
+		if rng.Intn(3) == 0 {
+			next = Remove(versions[i], it)
+			delete(expected, it)
+		} else {
+			next = Add(versions[i], it)
+			expected[it] = true
+		}
+
+		if len(versions) < 50 {
+			versions, models = append(versions, next), append(models, expected)
+		} else {
+			versions[i], models[i] = next, expected
+		}
+	}
+
+	for i, s := range versions {
+		assert.ElementsMatch(t, slices.Collect(maps.Keys(models[i])), ToSlice(s))
+	}
+}
+
+func BenchmarkAddVsCopy(b *testing.B) {
+	for _, size := range []int{10, 1000, 100000} {
+		builtin := make(map[int]struct{}, size)
+		for i := range size {
+			builtin[i] = struct{}{}
+		}
+		persistent := New(slices.Collect(maps.Keys(builtin))...)
+
+		b.Run(fmt.Sprint("pset/", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				Add(persistent, size+i)
+			}
+		})
+
+		b.Run(fmt.Sprint("map copy/", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				updated := maps.Clone(builtin)
+				updated[size+i] = struct{}{}
+			}
+		})
+	}
+}
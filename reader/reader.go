@@ -0,0 +1,101 @@
+package reader
+
+import "github.com/MisterKaiou/go-functional/result"
+
+// Of represents a computation that produces a value of type A from an environment of type Env, such as the
+// dependencies and configuration of a handler.
+//
+// The zero value of Of produces the zero value of A.
+type Of[Env, A any] struct {
+	run func(Env) A
+}
+
+// Result represents a computation that produces a result.Of[A] from an environment of type Env. Functions like BindOk
+// stop at the first error.
+type Result[Env, A any] = Of[Env, result.Of[A]]
+
+// New creates a new Of from a function that receives the environment.
+func New[Env, A any](f func(Env) A) Of[Env, A] {
+	return Of[Env, A]{run: f}
+}
+
+// Pure creates a new Of that ignores the environment and produces the given value.
+func Pure[Env, A any](it A) Of[Env, A] {
+	return New(func(Env) A { return it })
+}
+
+// Ask creates a new Of that produces the environment itself.
+func Ask[Env any]() Of[Env, Env] {
+	return New(func(env Env) Env { return env })
+}
+
+// Asks creates a new Of that produces the result of applying f to the environment.
+func Asks[Env, A any](f func(Env) A) Of[Env, A] {
+	return New(f)
+}
+
+// Local creates a new Of that runs m with the environment produced by applying f to the one it receives. It can be
+// used to adjust the environment for a part of a computation, or to run m as part of a larger environment.
+func Local[Outer, Env, A any](m Of[Env, A], f func(Outer) Env) Of[Outer, A] {
+	return New(func(env Outer) A { return Run(m, f(env)) })
+}
+
+// Map creates a new Of that applies the mapping function on the value produced by m.
+func Map[Env, A, To any](m Of[Env, A], mapping func(A) To) Of[Env, To] {
+	return New(func(env Env) To { return mapping(Run(m, env)) })
+}
+
+// Bind creates a new Of that passes the value produced by m to the binding function and runs the Of it returns with
+// the same environment.
+func Bind[Env, A, To any](m Of[Env, A], binding func(A) Of[Env, To]) Of[Env, To] {
+	return New(func(env Env) To { return Run(binding(Run(m, env)), env) })
+}
+
+// Run executes m with the given environment and returns the value it produces.
+func Run[Env, A any](m Of[Env, A], env Env) A {
+	if m.run == nil {
+		var zero A
+		return zero
+	}
+
+	return m.run(env)
+}
+
+// Ok creates a new Result that produces Ok holding the given value.
+func Ok[Env, A any](it A) Result[Env, A] {
+	return Pure[Env](result.Ok(it))
+}
+
+// Error creates a new Result that produces an error result holding the given error.
+func Error[Env, A any](err error) Result[Env, A] {
+	return Pure[Env](result.Error[A](err))
+}
+
+// FromResult creates a new Result that produces the given result.
+func FromResult[Env, A any](res result.Of[A]) Result[Env, A] {
+	return Pure[Env](res)
+}
+
+// Lift creates a new Result that produces Ok holding the value produced by m.
+func Lift[Env, A any](m Of[Env, A]) Result[Env, A] {
+	return Map(m, result.Ok[A])
+}
+
+// MapOk creates a new Result that applies the mapping function on the value produced by m, if it is Ok.
+func MapOk[Env, A, To any](m Result[Env, A], mapping func(A) To) Result[Env, To] {
+	return Map(m, func(res result.Of[A]) result.Of[To] { return result.Map(res, mapping) })
+}
+
+// BindOk creates a new Result that, if m produces Ok, passes its value to the binding function and runs the Result it
+// returns with the same environment.
+func BindOk[Env, A, To any](m Result[Env, A], binding func(A) Result[Env, To]) Result[Env, To] {
+	return New(func(env Env) result.Of[To] {
+		return result.Bind(Run(m, env), func(it A) result.Of[To] { return Run(binding(it), env) })
+	})
+}
+
+// BindResult creates a new Result that applies the binding function on the value produced by m, if it is Ok. It
+// allows functions written for result.Bind to be used on a Result.
+func BindResult[Env, A, To any](m Result[Env, A], binding func(A) result.Of[To]) Result[Env, To] {
+	return Map(m, func(res result.Of[A]) result.Of[To] { return result.Bind(res, binding) })
+}
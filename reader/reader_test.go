@@ -0,0 +1,128 @@
+package reader
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/MisterKaiou/go-functional/result"
+	"github.com/MisterKaiou/go-functional/unit"
+	"github.com/stretchr/testify/assert"
+)
+
+type config struct {
+	BaseURL string
+	Debug   bool
+}
+
+type env struct {
+	Config config
+	Nonce  func() result.Of[string]
+	Save   func(string) result.Of[unit.Unit]
+}
+
+func TestZeroValue(t *testing.T) {
+	var m Of[string, int]
+
+	assert.Equal(t, 0, Run(m, "env"))
+}
+
+func TestPure(t *testing.T) {
+	assert.Equal(t, 42, Run(Pure[string](42), "env"))
+}
+
+func TestAsk(t *testing.T) {
+	assert.Equal(t, "env", Run(Ask[string](), "env"))
+}
+
+func TestAsks(t *testing.T) {
+	assert.Equal(t, 3, Run(Asks(func(env string) int { return len(env) }), "env"))
+}
+
+func TestLocal(t *testing.T) {
+	upper := Local(Ask[string](), strings.ToUpper)
+	debug := Local(Asks(func(c config) bool { return c.Debug }), func(e env) config { return e.Config })
+
+	assert.Equal(t, "ENV", Run(upper, "env"))
+	assert.True(t, Run(debug, env{Config: config{Debug: true}}))
+}
+
+func TestMap(t *testing.T) {
+	m := Map(Ask[int](), func(it int) string { return fmt.Sprint(it) })
+
+	assert.Equal(t, "42", Run(m, 42))
+}
+
+func TestBind(t *testing.T) {
+	m := Bind(Asks(func(c config) string { return c.BaseURL }), func(base string) Of[config, string] {
+		return Asks(func(c config) string { return fmt.Sprintf("%s?debug=%t", base, c.Debug) })
+	})
+
+	assert.Equal(t, "http://localhost?debug=true", Run(m, config{BaseURL: "http://localhost", Debug: true}))
+}
+
+func TestOkError(t *testing.T) {
+	err := errors.New("error")
+
+	assert.Equal(t, result.Ok(42), Run(Ok[string](42), "env"))
+	assert.Equal(t, result.Error[int](err), Run(Error[string, int](err), "env"))
+	assert.Equal(t, result.Ok(42), Run(FromResult[string](result.Ok(42)), "env"))
+	assert.Equal(t, result.Ok("env"), Run(Lift(Ask[string]()), "env"))
+}
+
+func TestMapOk(t *testing.T) {
+	err := errors.New("error")
+
+	assert.Equal(t, result.Ok(3), Run(MapOk(Lift(Ask[string]()), func(s string) int { return len(s) }), "env"))
+	assert.Equal(t, result.Error[int](err), Run(MapOk(Error[string, string](err), func(s string) int { return len(s) }), "env"))
+}
+
+// login mirrors the README's Handler.Login, with the dependencies pulled from the environment.
+func login() Result[env, string] {
+	nonce := New(func(e env) result.Of[string] { return e.Nonce() })
+
+	saved := BindOk(nonce, func(it string) Result[env, string] {
+		return New(func(e env) result.Of[string] {
+			return result.Map(e.Save(it), func(unit.Unit) string { return it })
+		})
+	})
+
+	return BindOk(saved, func(state string) Result[env, string] {
+		return Asks(func(e env) result.Of[string] { return result.Ok(e.Config.BaseURL + "/auth?state=" + state) })
+	})
+}
+
+func TestBindOkWithSubstituteEnvironments(t *testing.T) {
+	var saved []string
+	ok := env{
+		Config: config{BaseURL: "http://localhost"},
+		Nonce:  func() result.Of[string] { return result.Ok("nonce") },
+		Save:   func(s string) result.Of[unit.Unit] { saved = append(saved, s); return result.Ok(unit.Unit{}) },
+	}
+	err := errors.New("store unavailable")
+	failing := ok
+	failing.Save = func(string) result.Of[unit.Unit] { return result.Error[unit.Unit](err) }
+	noNonce := ok
+	noNonce.Nonce = func() result.Of[string] { return result.Error[string](err) }
+
+	assert.Equal(t, result.Ok("http://localhost/auth?state=nonce"), Run(login(), ok))
+	assert.Equal(t, result.Error[string](err), Run(login(), failing))
+	assert.Equal(t, result.Error[string](err), Run(login(), noNonce))
+	assert.Equal(t, []string{"nonce"}, saved)
+}
+
+func TestBindResult(t *testing.T) {
+	err := errors.New("empty")
+	nonEmpty := func(s string) result.Of[string] {
+		if s == "" {
+			return result.Error[string](err)
+		}
+
+		return result.Ok(s)
+	}
+	m := BindResult(Lift(Ask[string]()), nonEmpty)
+
+	assert.Equal(t, result.Ok("env"), Run(m, "env"))
+	assert.Equal(t, result.Error[string](err), Run(m, ""))
+}
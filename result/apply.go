@@ -0,0 +1,134 @@
+package result
+
+import (
+	"github.com/MisterKaiou/go-functional/internal/core"
+	"github.com/MisterKaiou/go-functional/tuple"
+)
+
+// Apply applies the function held by fn on the value held by res if both are Ok, and returns it wrapped in a new Of.
+// Otherwise, returns the first error, checking fn before res.
+func Apply[T, To any](fn Of[func(T) To], res Of[T]) Of[To] {
+	f, err := core.Unpack(fn)
+	if err != nil {
+		return Error[To](err)
+	}
+
+	it, err := core.Unpack(res)
+	if err != nil {
+		return Error[To](err)
+	}
+
+	return Ok(f(it))
+}
+
+// Lift turns a function on plain values into a function on Of, that returns the error when given an error.
+func Lift[T, To any](f func(T) To) func(Of[T]) Of[To] {
+	return func(res Of[T]) Of[To] { return Map(res, f) }
+}
+
+// Lift2 turns a function of two plain values into a function of two Of, that returns the first error when given any.
+func Lift2[T1, T2, To any](f func(T1, T2) To) func(Of[T1], Of[T2]) Of[To] {
+	return func(r1 Of[T1], r2 Of[T2]) Of[To] { return Map2(r1, r2, f) }
+}
+
+// firstError returns the first of the given errors that is not nil.
+func firstError(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Map2 applies the mapping function on the inner values of the given Of, in the same order, if all of them are Ok.
+// Otherwise, returns the first error, in argument order.
+func Map2[T1, T2, To any](r1 Of[T1], r2 Of[T2], mapping func(T1, T2) To) Of[To] {
+	v1, err1 := core.Unpack(r1)
+	v2, err2 := core.Unpack(r2)
+	if err := firstError(err1, err2); err != nil {
+		return Error[To](err)
+	}
+
+	return Ok(mapping(v1, v2))
+}
+
+// Map3 applies the mapping function on the inner values of the given Of, in the same order, if all of them are Ok.
+// Otherwise, returns the first error, in argument order.
+func Map3[T1, T2, T3, To any](r1 Of[T1], r2 Of[T2], r3 Of[T3], mapping func(T1, T2, T3) To) Of[To] {
+	v1, err1 := core.Unpack(r1)
+	v2, err2 := core.Unpack(r2)
+	v3, err3 := core.Unpack(r3)
+	if err := firstError(err1, err2, err3); err != nil {
+		return Error[To](err)
+	}
+
+	return Ok(mapping(v1, v2, v3))
+}
+
+// Map4 applies the mapping function on the inner values of the given Of, in the same order, if all of them are Ok.
+// Otherwise, returns the first error, in argument order.
+func Map4[T1, T2, T3, T4, To any](
+	r1 Of[T1], r2 Of[T2], r3 Of[T3], r4 Of[T4], mapping func(T1, T2, T3, T4) To) Of[To] {
+	v1, err1 := core.Unpack(r1)
+	v2, err2 := core.Unpack(r2)
+	v3, err3 := core.Unpack(r3)
+	v4, err4 := core.Unpack(r4)
+	if err := firstError(err1, err2, err3, err4); err != nil {
+		return Error[To](err)
+	}
+
+	return Ok(mapping(v1, v2, v3, v4))
+}
+
+// Map5 applies the mapping function on the inner values of the given Of, in the same order, if all of them are Ok.
+// Otherwise, returns the first error, in argument order.
+func Map5[T1, T2, T3, T4, T5, To any](
+	r1 Of[T1], r2 Of[T2], r3 Of[T3], r4 Of[T4], r5 Of[T5], mapping func(T1, T2, T3, T4, T5) To) Of[To] {
+	v1, err1 := core.Unpack(r1)
+	v2, err2 := core.Unpack(r2)
+	v3, err3 := core.Unpack(r3)
+	v4, err4 := core.Unpack(r4)
+	v5, err5 := core.Unpack(r5)
+	if err := firstError(err1, err2, err3, err4, err5); err != nil {
+		return Error[To](err)
+	}
+
+	return Ok(mapping(v1, v2, v3, v4, v5))
+}
+
+// Map6 applies the mapping function on the inner values of the given Of, in the same order, if all of them are Ok.
+// Otherwise, returns the first error, in argument order.
+func Map6[T1, T2, T3, T4, T5, T6, To any](
+	r1 Of[T1], r2 Of[T2], r3 Of[T3], r4 Of[T4], r5 Of[T5], r6 Of[T6], mapping func(T1, T2, T3, T4, T5, T6) To) Of[To] {
+	v1, err1 := core.Unpack(r1)
+	v2, err2 := core.Unpack(r2)
+	v3, err3 := core.Unpack(r3)
+	v4, err4 := core.Unpack(r4)
+	v5, err5 := core.Unpack(r5)
+	v6, err6 := core.Unpack(r6)
+	if err := firstError(err1, err2, err3, err4, err5, err6); err != nil {
+		return Error[To](err)
+	}
+
+	return Ok(mapping(v1, v2, v3, v4, v5, v6))
+}
+
+// ComposeK returns a function that applies f to its argument and, if it returns Ok, binds its inner value to g. It
+// composes functions meant for Bind from left to right.
+func ComposeK[A, B, C any](f func(A) Of[B], g func(B) Of[C]) func(A) Of[C] {
+	return func(it A) Of[C] { return Bind(f(it), g) }
+}
+
+// Zip returns Ok holding a tuple.Pair with the inner values of both Of if both are Ok. Otherwise, returns the first
+// error.
+func Zip[A, B any](a Of[A], b Of[B]) Of[tuple.Pair[A, B]] {
+	return Map2(a, b, tuple.NewPair[A, B])
+}
+
+// Unzip splits an Of holding a tuple.Pair into an Of for each of its values. Both hold the same error if the given Of
+// is an error.
+func Unzip[A, B any](res Of[tuple.Pair[A, B]]) (Of[A], Of[B]) {
+	return Map(res, tuple.Pair[A, B].First), Map(res, tuple.Pair[A, B].Second)
+}
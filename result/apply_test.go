@@ -0,0 +1,127 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/MisterKaiou/go-functional/tuple"
+	"github.com/stretchr/testify/assert"
+)
+
+type address struct {
+	Street  string
+	Number  int
+	City    string
+	Country string
+	Zip     string
+	Primary bool
+}
+
+func TestApply(t *testing.T) {
+	err := errors.New("error")
+	fnErr := errors.New("fn error")
+	double := Ok(func(it int) int { return it * 2 })
+
+	assert.Equal(t, Ok(84), Apply(double, Ok(42)))
+	assert.Same(t, err, errorOf(Apply(double, Error[int](err))))
+	assert.Same(t, fnErr, errorOf(Apply(Error[func(int) int](fnErr), Error[int](err))))
+}
+
+func TestLift(t *testing.T) {
+	err := errors.New("error")
+	toString := Lift(func(it int) string { return fmt.Sprint(it) })
+
+	assert.Equal(t, Ok("42"), toString(Ok(42)))
+	assert.Equal(t, Error[string](err), toString(Error[int](err)))
+}
+
+func TestLift2(t *testing.T) {
+	err := errors.New("error")
+	sub := Lift2(func(a, b int) int { return a - b })
+
+	assert.Equal(t, Ok(1), sub(Ok(3), Ok(2)))
+	assert.Equal(t, Error[int](err), sub(Ok(3), Error[int](err)))
+}
+
+func TestMap2(t *testing.T) {
+	first := errors.New("first")
+	second := errors.New("second")
+	pair := func(s string, i int) string { return fmt.Sprint(s, i) }
+
+	assert.Equal(t, Ok("a1"), Map2(Ok("a"), Ok(1), pair))
+	assert.Same(t, first, errorOf(Map2(Error[string](first), Error[int](second), pair)))
+	assert.Same(t, second, errorOf(Map2(Ok("a"), Error[int](second), pair)))
+}
+
+func TestMap3(t *testing.T) {
+	err := errors.New("error")
+	f := func(s string, i int, c string) address { return address{Street: s, Number: i, City: c} }
+
+	assert.Equal(t, Ok(address{Street: "Main", Number: 1, City: "Town"}), Map3(Ok("Main"), Ok(1), Ok("Town"), f))
+	assert.Same(t, err, errorOf(Map3(Ok("Main"), Ok(1), Error[string](err), f)))
+}
+
+func TestMap4(t *testing.T) {
+	err := errors.New("error")
+	f := func(s string, i int, c, co string) address { return address{s, i, c, co, "", false} }
+
+	assert.Equal(t, Ok(address{"Main", 1, "Town", "BR", "", false}), Map4(Ok("Main"), Ok(1), Ok("Town"), Ok("BR"), f))
+	assert.Same(t, err, errorOf(Map4(Ok("Main"), Ok(1), Ok("Town"), Error[string](err), f)))
+}
+
+func TestMap5(t *testing.T) {
+	err := errors.New("error")
+	f := func(s string, i int, c, co, z string) address { return address{s, i, c, co, z, false} }
+
+	assert.Equal(t,
+		Ok(address{"Main", 1, "Town", "BR", "000", false}),
+		Map5(Ok("Main"), Ok(1), Ok("Town"), Ok("BR"), Ok("000"), f))
+	assert.Same(t, err, errorOf(Map5(Ok("Main"), Ok(1), Ok("Town"), Ok("BR"), Error[string](err), f)))
+}
+
+func TestMap6(t *testing.T) {
+	err := errors.New("error")
+	f := func(s string, i int, c, co, z string, p bool) address { return address{s, i, c, co, z, p} }
+
+	assert.Equal(t,
+		Ok(address{"Main", 1, "Town", "BR", "000", true}),
+		Map6(Ok("Main"), Ok(1), Ok("Town"), Ok("BR"), Ok("000"), Ok(true), f))
+	assert.Same(t, err, errorOf(Map6(Ok("Main"), Ok(1), Ok("Town"), Ok("BR"), Ok("000"), Error[bool](err), f)))
+}
+
+func TestComposeK(t *testing.T) {
+	err := errors.New("not positive")
+	positive := func(it int) Of[int] {
+		if it <= 0 {
+			return Error[int](err)
+		}
+
+		return Ok(it)
+	}
+	parsePositive := ComposeK(parse, positive)
+
+	assert.Equal(t, Ok(42), parsePositive("42"))
+	assert.Same(t, err, errorOf(parsePositive("-1")))
+	assert.True(t, IsError(parsePositive("nope")))
+}
+
+func TestZip(t *testing.T) {
+	err := errors.New("error")
+
+	assert.Equal(t, Ok(tuple.NewPair("a", 1)), Zip(Ok("a"), Ok(1)))
+	assert.Same(t, err, errorOf(Zip(Error[string](err), Ok(1))))
+	assert.Same(t, err, errorOf(Zip(Ok("a"), Error[int](err))))
+}
+
+func TestUnzip(t *testing.T) {
+	err := errors.New("error")
+
+	a, b := Unzip(Ok(tuple.NewPair("a", 1)))
+	errA, errB := Unzip(Error[tuple.Pair[string, int]](err))
+
+	assert.Equal(t, Ok("a"), a)
+	assert.Equal(t, Ok(1), b)
+	assert.Same(t, err, errorOf(errA))
+	assert.Same(t, err, errorOf(errB))
+}
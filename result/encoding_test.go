@@ -0,0 +1,161 @@
+package result
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"math/big"
+	"net/netip"
+	"testing"
+
+	"github.com/MisterKaiou/go-functional/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalText(t *testing.T) {
+	ok, okErr := Ok(42).MarshalText()
+	addr, addrErr := Ok(netip.MustParseAddr("127.0.0.1")).MarshalText()
+	failed, failedErr := Error[int](errors.New("oops")).MarshalText()
+
+	assert.NoError(t, okErr)
+	assert.NoError(t, addrErr)
+	assert.NoError(t, failedErr)
+	assert.Equal(t, "ok:42", string(ok))
+	assert.Equal(t, "ok:127.0.0.1", string(addr))
+	assert.Equal(t, "error:oops", string(failed))
+}
+
+func TestMarshalTextUnsupported(t *testing.T) {
+	_, err := Ok(struct{}{}).MarshalText()
+
+	assert.Error(t, err)
+}
+
+func TestUnmarshalText(t *testing.T) {
+	var ok Of[float64]
+	var failed Of[float64]
+	var empty Of[string]
+
+	assert.NoError(t, ok.UnmarshalText([]byte("ok:1.5")))
+	assert.NoError(t, failed.UnmarshalText([]byte("error:oops")))
+	assert.NoError(t, empty.UnmarshalText([]byte("ok:")))
+
+	assert.Equal(t, Ok(1.5), ok)
+	assert.True(t, failed.IsError())
+	assert.EqualError(t, errorOf(failed), "oops")
+	assert.Equal(t, Ok(""), empty)
+}
+
+func TestUnmarshalTextInvalid(t *testing.T) {
+	var res Of[int]
+
+	assert.ErrorIs(t, res.UnmarshalText([]byte("42")), ErrInvalidText)
+	assert.Error(t, res.UnmarshalText([]byte("ok:not a number")))
+	assert.Equal(t, Ok(0), res)
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	var ok Of[[]string]
+	var nested Of[option.Of[int]]
+	var failed Of[int]
+
+	assert.NoError(t, roundTripBinary(Ok([]string{"a", "b"}), &ok))
+	assert.NoError(t, roundTripBinary(Ok(option.Some(42)), &nested))
+	assert.NoError(t, roundTripBinary(Error[int](errors.New("oops")), &failed))
+
+	assert.Equal(t, Ok([]string{"a", "b"}), ok)
+	assert.Equal(t, Ok(option.Some(42)), nested)
+	assert.True(t, failed.IsError())
+	assert.EqualError(t, errorOf(failed), "oops")
+}
+
+func TestBigIntRoundTrip(t *testing.T) {
+	var text Of[*big.Int]
+	var binary Of[*big.Int]
+
+	data, err := Ok(big.NewInt(5)).MarshalText()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ok:5", string(data))
+	assert.NoError(t, text.UnmarshalText(data))
+	assert.NoError(t, roundTripBinary(Ok(big.NewInt(5)), &binary))
+
+	assert.Equal(t, Ok(big.NewInt(5)), text)
+	assert.Equal(t, Ok(big.NewInt(5)), binary)
+}
+
+func TestBinaryRoundTripNil(t *testing.T) {
+	type user struct{ Name string }
+	var ptr Of[*user]
+	var values Of[map[string]int]
+	var filled Of[*user]
+
+	assert.NoError(t, roundTripBinary(Ok[*user](nil), &ptr))
+	assert.NoError(t, roundTripBinary(Ok[map[string]int](nil), &values))
+	assert.NoError(t, roundTripBinary(Ok(&user{"name"}), &filled))
+
+	assert.Equal(t, Ok[*user](nil), ptr)
+	assert.Equal(t, Ok[map[string]int](nil), values)
+	assert.Equal(t, Ok(&user{"name"}), filled)
+}
+
+func TestUnmarshalBinaryInvalid(t *testing.T) {
+	var res Of[int]
+
+	assert.ErrorIs(t, res.UnmarshalBinary(nil), ErrInvalidBinary)
+	assert.ErrorIs(t, res.UnmarshalBinary([]byte{42}), ErrInvalidBinary)
+	assert.ErrorIs(t, res.UnmarshalBinary([]byte{2, 1}), ErrInvalidBinary)
+	assert.Error(t, res.UnmarshalBinary([]byte{0, 1, 2, 3}))
+}
+
+type rpcReply struct {
+	ID     int
+	Result Of[string]
+}
+
+func TestGob(t *testing.T) {
+	replies := []rpcReply{
+		{ID: 1, Result: Ok("done")},
+		{ID: 2, Result: Error[string](errors.New("timeout"))},
+	}
+	var buf bytes.Buffer
+	var decoded []rpcReply
+
+	err := gob.NewEncoder(&buf).Encode(replies)
+	assert.NoError(t, err)
+
+	err = gob.NewDecoder(&buf).Decode(&decoded)
+
+	assert.NoError(t, err)
+	assert.Equal(t, replies[0], decoded[0])
+	assert.Equal(t, 2, decoded[1].ID)
+	assert.EqualError(t, errorOf(decoded[1].Result), "timeout")
+}
+
+func TestGobNil(t *testing.T) {
+	type user struct{ Name string }
+	type reply struct {
+		ID   int
+		User Of[*user]
+	}
+	expected := reply{ID: 1, User: Ok[*user](nil)}
+	var buf bytes.Buffer
+	var decoded reply
+
+	err := gob.NewEncoder(&buf).Encode(expected)
+	assert.NoError(t, err)
+
+	err = gob.NewDecoder(&buf).Decode(&decoded)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, decoded)
+}
+
+func roundTripBinary[T any](res Of[T], into *Of[T]) error {
+	data, err := res.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	return into.UnmarshalBinary(data)
+}
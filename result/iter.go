@@ -0,0 +1,31 @@
+package result
+
+import (
+	"iter"
+
+	"github.com/MisterKaiou/go-functional/internal/core"
+)
+
+// All returns an iterator that yields the inner value of the given Of if it is Ok, and nothing if it is an error.
+func All[T any](res Of[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if it, err := core.Unpack(res); err == nil {
+			yield(it)
+		}
+	}
+}
+
+// CollectSeq gathers the values yielded by seq into a slice wrapped in an Ok. It stops at the first pair holding a
+// non-nil error, returning it as an Error.
+func CollectSeq[T any](seq iter.Seq2[T, error]) Of[[]T] {
+	var collected []T
+	for it, err := range seq {
+		if err != nil {
+			return Error[[]T](err)
+		}
+
+		collected = append(collected, it)
+	}
+
+	return Ok(collected)
+}
@@ -0,0 +1,56 @@
+package result
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllOk(t *testing.T) {
+	res := Ok("value")
+	var yielded []string
+
+	for it := range res.All() {
+		yielded = append(yielded, it)
+	}
+
+	assert.Equal(t, []string{"value"}, yielded)
+}
+
+func TestAllError(t *testing.T) {
+	assert.Empty(t, slices.Collect(All(Error[int](errors.New("error")))))
+}
+
+func parseAll(values ...string) func(yield func(int, error) bool) {
+	return func(yield func(int, error) bool) {
+		for _, v := range values {
+			if !yield(strconv.Atoi(v)) {
+				return
+			}
+		}
+	}
+}
+
+func TestCollectSeq(t *testing.T) {
+	collected := CollectSeq(parseAll("1", "2", "3"))
+
+	assert.Equal(t, Ok([]int{1, 2, 3}), collected)
+}
+
+func TestCollectSeqWithError(t *testing.T) {
+	collected := CollectSeq(parseAll("1", "two", "3"))
+
+	assert.True(t, collected.IsError())
+	assert.ErrorIs(t, errorOf(collected), strconv.ErrSyntax)
+}
+
+func TestCollectSeqEmpty(t *testing.T) {
+	collected := CollectSeq(maps.All(map[int]error{}))
+
+	assert.True(t, collected.IsOk())
+	assert.Empty(t, valueOf(collected))
+}
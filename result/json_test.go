@@ -0,0 +1,120 @@
+package result
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/MisterKaiou/go-functional/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalJSONOk(t *testing.T) {
+	data, err := json.Marshal(Ok(42))
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"ok":42}`, string(data))
+}
+
+func TestMarshalJSONError(t *testing.T) {
+	data, err := json.Marshal(Error[int](errors.New("oops")))
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"error":"oops"}`, string(data))
+}
+
+func TestUnmarshalJSONOk(t *testing.T) {
+	var res Of[string]
+
+	err := json.Unmarshal([]byte(`{"ok":"hello"}`), &res)
+
+	assert.NoError(t, err)
+	assert.Equal(t, Ok("hello"), res)
+}
+
+func TestUnmarshalJSONOkNull(t *testing.T) {
+	res := Ok(new(int))
+
+	err := json.Unmarshal([]byte(`{"ok":null}`), &res)
+
+	assert.NoError(t, err)
+	assert.True(t, res.IsOk())
+	assert.Nil(t, res.Unwrap())
+}
+
+func TestUnmarshalJSONError(t *testing.T) {
+	var res Of[string]
+
+	err := json.Unmarshal([]byte(`{"error":"oops"}`), &res)
+
+	assert.NoError(t, err)
+	assert.True(t, res.IsError())
+	assert.EqualError(t, errorOf(res), "oops")
+}
+
+func TestUnmarshalJSONInvalid(t *testing.T) {
+	for _, data := range []string{`{}`, `{"ok":1,"error":"oops"}`, `{"other":1}`} {
+		var res Of[int]
+
+		err := json.Unmarshal([]byte(data), &res)
+
+		assert.ErrorIs(t, err, ErrInvalidJSON, data)
+	}
+
+	var res Of[int]
+
+	err := json.Unmarshal([]byte(`{"ok":"not a number"}`), &res)
+
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrInvalidJSON)
+}
+
+func TestJSONRoundTripNested(t *testing.T) {
+	cases := []Of[option.Of[int]]{
+		Ok(option.Some(42)),
+		Ok(option.None[int]()),
+		Error[option.Of[int]](errors.New("oops")),
+	}
+
+	for _, expected := range cases {
+		var decoded Of[option.Of[int]]
+		data, err := json.Marshal(expected)
+		assert.NoError(t, err)
+
+		err = json.Unmarshal(data, &decoded)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected.IsOk(), decoded.IsOk())
+		assert.Equal(t, expected.String(), decoded.String())
+	}
+}
+
+type response struct {
+	Body Of[int] `json:"body"`
+}
+
+func TestJSONStructField(t *testing.T) {
+	data, err := json.Marshal(response{Body: Ok(1)})
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"body":{"ok":1}}`, string(data))
+
+	var decoded response
+	err = json.Unmarshal(data, &decoded)
+
+	assert.NoError(t, err)
+	assert.Equal(t, Ok(1), decoded.Body)
+}
+
+type custom struct{ A int }
+
+func (c *custom) MarshalJSON() ([]byte, error) {
+	return []byte(`"custom"`), nil
+}
+
+func TestMarshalJSONPointerReceiver(t *testing.T) {
+	data, err := json.Marshal(struct{ F Of[custom] }{Ok(custom{})})
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"F":{"ok":"custom"}}`, string(data))
+}
@@ -1,78 +1,71 @@
 package result
 
 import (
-	"fmt"
-
+	"github.com/MisterKaiou/go-functional/internal/core"
 	"github.com/MisterKaiou/go-functional/option"
+	"github.com/MisterKaiou/go-functional/tuple"
 	"github.com/MisterKaiou/go-functional/unit"
 )
 
-type ok[Of any] any
+var (
+	// ErrNilError is the error held by an Of created with a nil error. An error result always carries a non-nil error.
+	ErrNilError = core.ErrNilError
+	// ErrInvalidJSON is returned when decoding a JSON value that is neither {"ok": ...} nor {"error": "..."}.
+	ErrInvalidJSON = core.ErrInvalidJSON
+	// ErrInvalidText is returned when decoding text that was not produced by Of.MarshalText.
+	ErrInvalidText = core.ErrInvalidText
+	// ErrInvalidBinary is returned when decoding binary data that was not produced by Of.MarshalBinary.
+	ErrInvalidBinary = core.ErrInvalidBinary
+)
 
 // Of represents a result that can be either an input of given type, or error.
-type Of[Ok any] struct {
-	ok  ok[Ok]
-	err error
-}
-
-// The value returned when calling this method depends on the state it represents. If ok return fmt.String applied to
-// its internal value; if error, return error.Error()
-func (r *Of[Ok]) String() string {
-	if r.IsError() {
-		return r.err.Error()
-	}
-
-	return fmt.Sprint(r.ok)
-}
-
-func (r *Of[Ok]) IsOk() bool {
-	return r.err == nil
-}
+//
+// The zero value of Of is Ok holding the zero value of its type.
+//
+// Of can be encoded as JSON ({"ok": value} or {"error": "message"}), as text ("ok:value" or "error:message"), as binary
+// data and with encoding/gob. Decoded errors only keep their message, so they are recreated with errors.New.
+//
+// Of is defined in an internal package, shared with the option package, so its methods are not listed here. They are:
+//
+//	func (r *Of[Ok]) IsOk() bool                     // Reports whether r is Ok.
+//	func (r *Of[Ok]) IsError() bool                  // Reports whether r is an error.
+//	func (r *Of[Ok]) Unwrap() Ok                     // Returns the inner value; panics if r is an error.
+//	func (r *Of[Ok]) UnwrapError() error             // Returns the error; panics if r is Ok.
+//	func (r *Of[Ok]) String() string                 // fmt.Sprint of the inner value, or error.Error().
+//	func (r *Of[Ok]) All() iter.Seq[Ok]              // Yields the inner value if r is Ok, nothing otherwise.
+//	func (r Of[Ok]) MarshalJSON() ([]byte, error)    // {"ok": value} or {"error": "message"}.
+//	func (r *Of[Ok]) UnmarshalJSON([]byte) error     // Decodes MarshalJSON; fails with ErrInvalidJSON.
+//	func (r Of[Ok]) MarshalText() ([]byte, error)    // "ok:value" or "error:message".
+//	func (r *Of[Ok]) UnmarshalText([]byte) error     // Decodes MarshalText; fails with ErrInvalidText.
+//	func (r Of[Ok]) MarshalBinary() ([]byte, error)  // A tag byte followed by the inner value or the error message.
+//	func (r *Of[Ok]) UnmarshalBinary([]byte) error   // Decodes MarshalBinary; fails with ErrInvalidBinary.
+type Of[Ok any] = core.Result[Ok]
 
 func IsOk[T any](res Of[T]) bool {
-	return res.IsOk()
-}
-
-func (r *Of[Ok]) IsError() bool {
-	return !r.IsOk()
+	_, err := core.Unpack(res)
+	return err == nil
 }
 
 func IsError[T any](res Of[T]) bool {
-	return res.IsError()
-}
-
-// Unwrap can panic if this Of is an error. Prefer Match over this
-func (r *Of[Ok]) Unwrap() Ok {
-	if r.IsError() {
-		panic("cannot get the value of an error")
-	}
-
-	return r.ok.(Ok)
-}
-
-// UnwrapError can panic if this Of is not an error.
-func (r *Of[Ok]) UnwrapError() error {
-	if r.IsOk() {
-		panic("cannot get the error of an sucessful result")
-	}
-
-	return r.err
+	return !IsOk(res)
 }
 
 // Map applies the mapping function on the result's internal value if is not an error, and returns a new result.
 func Map[From any, To any](res Of[From], mapping func(From) To) Of[To] {
-	if res.IsError() {
-		return Error[To](res.err)
+	it, err := core.Unpack(res)
+	if err != nil {
+		return Error[To](err)
 	}
 
-	return Ok[To](mapping(res.ok.(From)))
+	return Ok[To](mapping(it))
 }
 
 // MapError applies the given mapping function on the result's internal error, if it is an error, and returns a new
-// result, else returns the same instance provided.
+// result, else returns the same instance provided. If the mapping returns nil, the new result holds ErrNilError.
 func MapError[T any](res Of[T], mapping func(error) error) Of[T] {
-	if res.IsError() {
-		return Error[T](mapping(res.err))
+	_, err := core.Unpack(res)
+	if err != nil {
+		return Error[T](mapping(err))
 	}
 
 	return res
@@ -80,37 +73,33 @@ func MapError[T any](res Of[T], mapping func(error) error) Of[T] {
 
 // Bind accepts a function that takes the Of internal value and returns another Of
 func Bind[From any, To any](res Of[From], binding func(From) Of[To]) Of[To] {
-	if res.IsError() {
-		return Error[To](res.err)
+	it, err := core.Unpack(res)
+	if err != nil {
+		return Error[To](err)
 	}
 
-	return binding(res.ok.(From))
+	return binding(it)
 }
 
 // Match accepts two functions that return a value of the same type, but the first one receives the result
 // contained in it and the second one receives the error.
 func Match[Ok any, To any](res Of[Ok], ok func(Ok) To, failed func(error) To) To {
-	if res.IsError() {
-		return failed(res.err)
+	it, err := core.Unpack(res)
+	if err != nil {
+		return failed(err)
 	}
 
-	return ok(res.ok.(Ok))
+	return ok(it)
 }
 
 // Ok creates a new Of representing an Ok state.
 func Ok[Ok any](it Ok) Of[Ok] {
-	return Of[Ok]{
-		ok:  it,
-		err: nil,
-	}
+	return core.Ok(it)
 }
 
-// Error create a new Of that represents an Error state.
+// Error create a new Of that represents an Error state. If err is nil, the returned Of holds ErrNilError instead.
 func Error[Ok any](err error) Of[Ok] {
-	return Of[Ok]{
-		ok:  nil,
-		err: err,
-	}
+	return core.Error[Ok](err)
 }
 
 // FromTupleOf creates a new Of base on the values provided. If err is not nil, this result will represent an error.
@@ -122,107 +111,157 @@ func FromTupleOf[T any](it T, err error) Of[T] {
 	return Ok[T](it)
 }
 
+// FromTuple2 creates a new Of holding a tuple.Pair with the values provided. If err is not nil, this result will
+// represent an error.
+func FromTuple2[A, B any](a A, b B, err error) Of[tuple.Pair[A, B]] {
+	if err != nil {
+		return Error[tuple.Pair[A, B]](err)
+	}
+
+	return Ok(tuple.NewPair(a, b))
+}
+
+// FromTuple3 creates a new Of holding a tuple.Triple with the values provided. If err is not nil, this result will
+// represent an error.
+func FromTuple3[A, B, C any](a A, b B, c C, err error) Of[tuple.Triple[A, B, C]] {
+	if err != nil {
+		return Error[tuple.Triple[A, B, C]](err)
+	}
+
+	return Ok(tuple.NewTriple(a, b, c))
+}
+
 // Contains compare the content of the provided Of against the given expected value.
 func Contains[T comparable](res Of[T], expected T) bool {
-	if res.IsError() {
+	it, err := core.Unpack(res)
+	if err != nil {
 		return false
 	}
 
-	return res.ok == expected
+	return it == expected
 }
 
 // DefaultValue returns the inner value of this Of or the provided default value.
 func DefaultValue[T any](res Of[T], or T) T {
-	if res.IsError() {
+	it, err := core.Unpack(res)
+	if err != nil {
 		return or
 	}
 
-	return res.ok.(T)
+	return it
 }
 
 // DefaultWith returns the inner value of this Of or executes the provided function with its inner error.
 func DefaultWith[T any](res Of[T], def func(error) T) T {
-	if res.IsError() {
-		return def(res.err)
+	it, err := core.Unpack(res)
+	if err != nil {
+		return def(err)
 	}
 
-	return res.ok.(T)
+	return it
 }
 
 // Exists tests the Of inner value against the given predicate.
 func Exists[T any](res Of[T], predicate func(T) bool) bool {
-	if res.IsError() {
+	it, err := core.Unpack(res)
+	if err != nil {
 		return false
 	}
 
-	return predicate(res.ok.(T))
+	return predicate(it)
 }
 
 // Fold applies the folder function passing the provided state and the Of inner value to it and returns the updated State.
 func Fold[T, State any](res Of[T], state State, folder func(State, T) State) State {
-	if res.IsError() {
+	it, err := core.Unpack(res)
+	if err != nil {
 		return state
 	}
 
-	return folder(state, res.ok.(T))
+	return folder(state, it)
 }
 
 // FoldTo applies the folder function passing the provided state and the Of inner value and returns a new value from it.
 func FoldTo[T, State, To any](res Of[T], state State, folder func(State, T) To) Of[To] {
-	if res.IsError() {
-		return Error[To](res.err)
+	it, err := core.Unpack(res)
+	if err != nil {
+		return Error[To](err)
 	}
 
-	return Ok(folder(state, res.ok.(T)))
+	return Ok(folder(state, it))
 }
 
 // FoldM applies the folder function, passing the provided state and the Of inner value to it and returns State
 // wrapped in a Of.
 func FoldM[T, State any](res Of[T], state State, folder func(State, T) State) Of[State] {
-	if res.IsError() {
-		return Error[State](res.err)
+	it, err := core.Unpack(res)
+	if err != nil {
+		return Error[State](err)
 	}
 
-	return Ok(folder(state, res.ok.(T)))
+	return Ok(folder(state, it))
 }
 
-// CombineBy applies the combiner function on State and the current Of by unwrapping them.
+// CombineBy applies the combiner function on State and the current Of by unwrapping them. Note that the combiner
+// receives the value of state first; prefer Map2, which passes the values in the same order as its arguments.
 func CombineBy[It, With, To any](res Of[It], state Of[With], combiner func(With, It) To) Of[To] {
-	if res.IsError() {
-		return Error[To](res.err)
+	it, err := core.Unpack(res)
+	if err != nil {
+		return Error[To](err)
 	}
 
-	if state.IsError() {
-		return Error[To](state.err)
+	with, err := core.Unpack(state)
+	if err != nil {
+		return Error[To](err)
 	}
 
-	return Ok(combiner(state.ok.(With), res.ok.(It)))
+	return Ok(combiner(with, it))
 }
 
 // Iter applies the given action to the inner value of the Of provided.
 func Iter[T any](res Of[T], action func(it T) unit.Unit) unit.Unit {
-	if res.IsError() {
+	it, err := core.Unpack(res)
+	if err != nil {
 		return unit.Unit{}
 	}
 
-	return action(res.ok.(T))
+	return action(it)
 }
 
 // Flatten returns a Of from a Of of Of.
 func Flatten[T any](res Of[Of[T]]) Of[T] {
-	if res.IsError() {
-		return Error[T](res.err)
+	it, err := core.Unpack(res)
+	if err != nil {
+		return Error[T](err)
 	}
 
-	return res.ok.(Of[T])
+	return it
 }
 
 // ToOption creates an Of from the given Of. If error, the returned Of will be None, else Some with the inner
 // value.
 func ToOption[T any](res Of[T]) option.Of[T] {
-	if res.IsError() {
+	it, err := core.Unpack(res)
+	if err != nil {
 		return option.None[T]()
 	}
 
-	return option.Some(res.ok.(T))
+	return option.Some(it)
+}
+
+// FromOption creates an Of from the given option.Of. If Some, the returned Of is Ok with its inner value, else it is
+// an error holding err.
+func FromOption[T any](opt option.Of[T], err error) Of[T] {
+	return option.ToResult(opt, err)
+}
+
+// Transpose turns an Of of an option.Of into an option.Of of an Of. Ok holding None becomes None, Ok holding Some
+// becomes Some holding Ok, and an error becomes Some holding that error.
+func Transpose[T any](res Of[option.Of[T]]) option.Of[Of[T]] {
+	it, err := core.Unpack(res)
+	if err != nil {
+		return option.Some(Error[T](err))
+	}
+
+	return option.Map(it, Ok[T])
 }
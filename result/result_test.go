@@ -5,35 +5,68 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/MisterKaiou/go-functional/internal/core"
 	"github.com/MisterKaiou/go-functional/option"
+	"github.com/MisterKaiou/go-functional/tuple"
 	"github.com/MisterKaiou/go-functional/unit"
 
 	"github.com/stretchr/testify/assert"
 )
 
+// valueOf returns the inner value of res, or the zero value of its type if it is an error.
+func valueOf[T any](res Of[T]) T {
+	it, _ := core.Unpack(res)
+	return it
+}
+
+// errorOf returns the inner error of res, or nil if it is Ok.
+func errorOf[T any](res Of[T]) error {
+	_, err := core.Unpack(res)
+	return err
+}
+
 func TestOk(t *testing.T) {
 	s := "result"
 	valRes := Ok(s)
 
 	// Testing Pass by Value
-	assert.Equal(t, valRes.ok, s)
-	assert.NotSame(t, &valRes.ok, &s)
-	assert.Nil(t, valRes.err)
+	it, err := core.Unpack(valRes)
+	assert.Equal(t, it, s)
+	assert.NotSame(t, &it, &s)
+	assert.Nil(t, err)
 
 	refRes := Ok(&s)
 
 	// Testing Pass by Reference
-	assert.Equal(t, s, *(refRes.ok.(*string)))
-	assert.Equal(t, &s, refRes.ok)
-	assert.Nil(t, refRes.err)
+	assert.Equal(t, s, *valueOf(refRes))
+	assert.Equal(t, &s, valueOf(refRes))
+	assert.Same(t, &s, valueOf(refRes))
+	assert.Nil(t, errorOf(refRes))
 }
 
 func TestErr(t *testing.T) {
 	err := errors.New("some error")
 	res := Error[int](err)
 
-	assert.Equal(t, err, res.err)
-	assert.Equal(t, &err, &res.err)
+	assert.Equal(t, err, errorOf(res))
+	assert.Same(t, err, errorOf(res))
+}
+
+func TestErrNil(t *testing.T) {
+	res := Error[int](nil)
+
+	assert.True(t, res.IsError())
+	assert.Same(t, ErrNilError, errorOf(res))
+	assert.Panics(t, func() { res.Unwrap() })
+}
+
+func TestZeroValue(t *testing.T) {
+	var res Of[int]
+
+	assert.True(t, res.IsOk())
+	assert.Equal(t, 0, res.Unwrap())
+	assert.Equal(t, Ok(0), res)
+	assert.Equal(t, "0", res.String())
 }
 
 func TestMapNoError(t *testing.T) {
@@ -42,7 +75,7 @@ func TestMapNoError(t *testing.T) {
 
 	mappedRes := Map(res, func(val int) string { return fmt.Sprint(val) })
 
-	assert.Equal(t, "42", mappedRes.ok)
+	assert.Equal(t, "42", valueOf(mappedRes))
 }
 
 func TestMapWithError(t *testing.T) {
@@ -51,9 +84,8 @@ func TestMapWithError(t *testing.T) {
 
 	mappedRes := Map(res, func(val int) bool { return val == 0 })
 
-	assert.Equal(t, err, mappedRes.err)
-	assert.Equal(t, &err, &mappedRes.err)
-	assert.Nil(t, mappedRes.ok)
+	assert.Equal(t, err, errorOf(mappedRes))
+	assert.Zero(t, valueOf(mappedRes))
 }
 
 func TestMapErrorNoError(t *testing.T) {
@@ -62,8 +94,8 @@ func TestMapErrorNoError(t *testing.T) {
 
 	mapped := MapError(res, func(err error) error { return errors.New("some error") })
 
-	assert.Nil(t, res.err)
-	assert.Equal(t, value, mapped.ok)
+	assert.Nil(t, errorOf(res))
+	assert.Equal(t, value, valueOf(mapped))
 }
 
 func TestMapErrorWithError(t *testing.T) {
@@ -72,19 +104,28 @@ func TestMapErrorWithError(t *testing.T) {
 
 	mapped := MapError(res, func(err error) error { return errors.New(fmt.Sprint("oh no ", err)) })
 
-	assert.NotNil(t, res.err)
-	assert.Equal(t, "oh no something failed", mapped.err.Error())
+	assert.NotNil(t, errorOf(res))
+	assert.Equal(t, "oh no something failed", errorOf(mapped).Error())
 	assert.NotSame(t, res, mapped)
 }
 
+func TestMapErrorToNil(t *testing.T) {
+	res := Error[int](errors.New("something failed"))
+
+	mapped := MapError(res, func(err error) error { return nil })
+
+	assert.True(t, mapped.IsError())
+	assert.ErrorIs(t, errorOf(mapped), ErrNilError)
+}
+
 func TestBindNoError(t *testing.T) {
 	value := 42
 	res := Ok(value)
 
 	boundRes := Bind(res, func(val int) Of[string] { return Ok(fmt.Sprint(val)) })
 
-	assert.Equal(t, "42", boundRes.ok)
-	assert.Nil(t, boundRes.err)
+	assert.Equal(t, "42", valueOf(boundRes))
+	assert.Nil(t, errorOf(boundRes))
 }
 
 func TestBindWithError(t *testing.T) {
@@ -93,9 +134,8 @@ func TestBindWithError(t *testing.T) {
 
 	boundRes := Bind(res, func(val int) Of[bool] { return Ok(val == 0) })
 
-	assert.Equal(t, err, boundRes.err)
-	assert.Equal(t, &err, &boundRes.err)
-	assert.Nil(t, boundRes.ok)
+	assert.Equal(t, err, errorOf(boundRes))
+	assert.Zero(t, valueOf(boundRes))
 }
 
 func TestMatchNoError(t *testing.T) {
@@ -160,7 +200,7 @@ func TestStringError(t *testing.T) {
 	expected := errors.New("hello from the other side")
 	res := Error[bool](expected)
 
-	assert.Equal(t, res.err.Error(), res.String())
+	assert.Equal(t, errorOf(res).Error(), res.String())
 }
 
 func TestFromTupleOfNoError(t *testing.T) {
@@ -169,8 +209,8 @@ func TestFromTupleOfNoError(t *testing.T) {
 
 	res := FromTupleOf[int](funcThatReturnsATuple())
 
-	assert.Equal(t, expected, res.ok)
-	assert.Nil(t, res.err)
+	assert.Equal(t, expected, valueOf(res))
+	assert.Nil(t, errorOf(res))
 }
 
 func TestFromTupleOfWithError(t *testing.T) {
@@ -179,8 +219,29 @@ func TestFromTupleOfWithError(t *testing.T) {
 
 	res := FromTupleOf[int](funcThatReturnsATuple())
 
-	assert.Equal(t, expected, res.err)
-	assert.Nil(t, res.ok)
+	assert.Equal(t, expected, errorOf(res))
+	assert.Zero(t, valueOf(res))
+}
+
+func TestFromTuple2(t *testing.T) {
+	err := errors.New("oops")
+	funcThatReturnsATuple := func(fail bool) (string, int, error) {
+		if fail {
+			return "", 0, err
+		}
+
+		return "a", 1, nil
+	}
+
+	assert.Equal(t, Ok(tuple.NewPair("a", 1)), FromTuple2(funcThatReturnsATuple(false)))
+	assert.Equal(t, err, errorOf(FromTuple2(funcThatReturnsATuple(true))))
+}
+
+func TestFromTuple3(t *testing.T) {
+	err := errors.New("oops")
+
+	assert.Equal(t, Ok(tuple.NewTriple("a", 1, true)), FromTuple3("a", 1, true, nil))
+	assert.Equal(t, err, errorOf(FromTuple3("a", 1, true, err)))
 }
 
 func TestContains(t *testing.T) {
@@ -250,7 +311,7 @@ func TestFoldM(t *testing.T) {
 
 	okFoldm := FoldM(res, 110, func(s int, i int) int { return s + i })
 
-	assert.Equal(t, expected, okFoldm.ok)
+	assert.Equal(t, expected, valueOf(okFoldm))
 	assert.True(t, okFoldm.IsOk())
 
 	errFoldm := FoldM(err, value, func(s int, i int) int { return s + 1 })
@@ -268,7 +329,7 @@ func TestCombineByNoError(t *testing.T) {
 	})
 
 	assert.True(t, combined.IsOk())
-	assert.True(t, combined.ok.(bool))
+	assert.True(t, valueOf(combined))
 }
 
 func TestCombineByWithError(t *testing.T) {
@@ -283,7 +344,7 @@ func TestCombineByWithError(t *testing.T) {
 	combined := CombineBy(left, right, combiningFunc)
 
 	assert.True(t, combined.IsError())
-	assert.Same(t, err, combined.err)
+	assert.Same(t, err, errorOf(combined))
 
 	left = Ok(69)
 	right = Error[string](err)
@@ -291,7 +352,7 @@ func TestCombineByWithError(t *testing.T) {
 	combined = CombineBy(left, right, combiningFunc)
 
 	assert.True(t, combined.IsError())
-	assert.Same(t, err, combined.err)
+	assert.Same(t, err, errorOf(combined))
 }
 
 func TestIter(t *testing.T) {
@@ -303,12 +364,12 @@ func TestIter(t *testing.T) {
 
 	Iter(res, incrementPtr)
 
-	assert.Equal(t, expected, *(res.ok.(*int)))
-	assert.Same(t, &value, res.ok)
+	assert.Equal(t, expected, *valueOf(res))
+	assert.Same(t, &value, valueOf(res))
 
 	Iter(err, incrementPtr)
 
-	assert.Nil(t, err.ok)
+	assert.Zero(t, valueOf(err))
 }
 
 func TestFlatten(t *testing.T) {
@@ -334,7 +395,7 @@ func TestFlattenError(t *testing.T) {
 	inner = Flatten(errErr)
 
 	assert.True(t, inner.IsError())
-	assert.Same(t, inner.err, err)
+	assert.Same(t, errorOf(inner), err)
 }
 
 func TestToOption(t *testing.T) {
@@ -346,6 +407,33 @@ func TestToOption(t *testing.T) {
 	assert.Equal(t, option.None[int](), ToOption(err))
 }
 
+func TestFromOption(t *testing.T) {
+	err := errors.New("missing")
+
+	assert.Equal(t, Ok(146), FromOption(option.Some(146), err))
+	assert.Equal(t, Error[int](err), FromOption(option.None[int](), err))
+}
+
+func TestTranspose(t *testing.T) {
+	err := errors.New("error")
+
+	assert.Equal(t, option.None[Of[int]](), Transpose(Ok(option.None[int]())))
+	assert.Equal(t, option.Some(Ok(146)), Transpose(Ok(option.Some(146))))
+	assert.Equal(t, option.Some(Error[int](err)), Transpose(Error[option.Of[int]](err)))
+}
+
+func TestTransposeRoundTrip(t *testing.T) {
+	values := []Of[option.Of[int]]{
+		Ok(option.None[int]()),
+		Ok(option.Some(146)),
+		Error[option.Of[int]](errors.New("error")),
+	}
+
+	for _, it := range values {
+		assert.Equal(t, it, option.Transpose(Transpose(it)))
+	}
+}
+
 func BenchmarkFoldVsMapVsFoldM(b *testing.B) {
 	b.Run("Fold", func(b *testing.B) {
 		b.ReportAllocs()
@@ -376,3 +464,184 @@ func BenchmarkFoldVsMapVsFoldM(b *testing.B) {
 		}
 	})
 }
+
+type point struct{ x, y, z int }
+
+func TestZeroAllocs(t *testing.T) {
+	p := point{1, 2, 3}
+	var res Of[point]
+	var matched bool
+
+	cases := map[string]func(){
+		"Ok":    func() { res = Ok(p) },
+		"Map":   func() { res = Map(Ok(p), func(it point) point { return point{it.z, it.y, it.x} }) },
+		"Bind":  func() { res = Bind(Ok(p), Ok[point]) },
+		"Match": func() { matched = Match(Ok(p), func(point) bool { return true }, func(error) bool { return false }) },
+	}
+
+	for name, f := range cases {
+		assert.Zero(t, testing.AllocsPerRun(100, f), name)
+	}
+
+	assert.Equal(t, Ok(p), res)
+	assert.True(t, matched)
+}
+
+func BenchmarkFunctions(b *testing.B) {
+	p := point{1, 2, 3}
+	err := errors.New("error")
+	isOrigin := func(it point) bool { return it == point{} }
+
+	b.Run("Ok", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Ok(p)
+		}
+	})
+
+	b.Run("Error", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Error[point](err)
+		}
+	})
+
+	b.Run("FromTupleOf", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			FromTupleOf(p, nil)
+		}
+	})
+
+	b.Run("IsOk", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			IsOk(Ok(p))
+		}
+	})
+
+	b.Run("IsError", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			IsError(Ok(p))
+		}
+	})
+
+	b.Run("Unwrap", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ok := Ok(p)
+
+			ok.Unwrap()
+		}
+	})
+
+	b.Run("String", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ok := Ok(p)
+
+			_ = ok.String()
+		}
+	})
+
+	b.Run("UnwrapError", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			e := Error[point](err)
+
+			e.UnwrapError()
+		}
+	})
+
+	b.Run("Map", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Map(Ok(p), func(it point) point { it.x += i; return it })
+		}
+	})
+
+	b.Run("MapError", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			MapError(Error[point](err), func(e error) error { return e })
+		}
+	})
+
+	b.Run("Bind", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Bind(Ok(p), func(it point) Of[point] { it.x += i; return Ok(it) })
+		}
+	})
+
+	b.Run("Match", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Match(Ok(p), func(it point) int { return it.x + i }, func(error) int { return i })
+		}
+	})
+
+	b.Run("Contains", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Contains(Ok(p), p)
+		}
+	})
+
+	b.Run("DefaultValue", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			DefaultValue(Error[point](err), p)
+		}
+	})
+
+	b.Run("DefaultWith", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			DefaultWith(Error[point](err), func(error) point { return p })
+		}
+	})
+
+	b.Run("Exists", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Exists(Ok(p), isOrigin)
+		}
+	})
+
+	b.Run("FoldTo", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			FoldTo(Ok(p), i, func(st int, it point) point { it.x += st; return it })
+		}
+	})
+
+	b.Run("CombineBy", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			CombineBy(Ok(p), Ok(i), func(st int, it point) point { it.x += st; return it })
+		}
+	})
+
+	b.Run("Iter", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Iter(Ok(p), func(it point) unit.Unit { return unit.Unit{} })
+		}
+	})
+
+	b.Run("Flatten", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Flatten(Ok(Ok(p)))
+		}
+	})
+
+	b.Run("ToOption", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ToOption(Ok(p))
+		}
+	})
+}
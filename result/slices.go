@@ -0,0 +1,51 @@
+package result
+
+import (
+	"errors"
+
+	"github.com/MisterKaiou/go-functional/internal/core"
+)
+
+// Partition splits a slice of Of into the values of the Ok elements and the errors of the failed ones, both in order.
+func Partition[T any](items []Of[T]) ([]T, []error) {
+	var oks []T
+	var errs []error
+	for _, res := range items {
+		it, err := core.Unpack(res)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		oks = append(oks, it)
+	}
+
+	return oks, errs
+}
+
+// CollectOks returns the values of the Ok elements of the slice, in order.
+func CollectOks[T any](items []Of[T]) []T {
+	oks, _ := Partition(items)
+	return oks
+}
+
+// CollectErrors returns the errors of the failed elements of the slice, in order.
+func CollectErrors[T any](items []Of[T]) []error {
+	_, errs := Partition(items)
+	return errs
+}
+
+// AllOk returns Ok with the values of every element of the slice if none of them failed. Otherwise, it returns an
+// error joining the errors of all the failed elements with errors.Join.
+func AllOk[T any](items []Of[T]) Of[[]T] {
+	oks, errs := Partition(items)
+	if len(errs) > 0 {
+		return Error[[]T](errors.Join(errs...))
+	}
+
+	if oks == nil {
+		oks = []T{}
+	}
+
+	return Ok(oks)
+}
@@ -0,0 +1,53 @@
+package result
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartition(t *testing.T) {
+	first := errors.New("first")
+	second := errors.New("second")
+
+	oks, errs := Partition([]Of[int]{Ok(1), Error[int](first), Ok(2), Error[int](second)})
+
+	assert.Equal(t, []int{1, 2}, oks)
+	assert.Equal(t, []error{first, second}, errs)
+}
+
+func TestPartitionEmpty(t *testing.T) {
+	oks, errs := Partition([]Of[int]{})
+
+	assert.Empty(t, oks)
+	assert.Empty(t, errs)
+}
+
+func TestCollectOks(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, CollectOks([]Of[string]{Ok("a"), Error[string](errors.New("error")), Ok("b")}))
+}
+
+func TestCollectErrors(t *testing.T) {
+	err := errors.New("error")
+
+	assert.Equal(t, []error{err}, CollectErrors([]Of[string]{Ok("a"), Error[string](err)}))
+	assert.Empty(t, CollectErrors([]Of[string]{Ok("a")}))
+}
+
+func TestAllOkNoError(t *testing.T) {
+	assert.Equal(t, Ok([]int{1, 2}), AllOk([]Of[int]{Ok(1), Ok(2)}))
+	assert.Equal(t, Ok([]int{}), AllOk([]Of[int]{}))
+}
+
+func TestAllOkWithErrors(t *testing.T) {
+	first := errors.New("first")
+	second := errors.New("second")
+
+	res := AllOk([]Of[int]{Ok(1), Error[int](first), Error[int](second)})
+
+	assert.True(t, res.IsError())
+	assert.ErrorIs(t, errorOf(res), first)
+	assert.ErrorIs(t, errorOf(res), second)
+	assert.Equal(t, "first\nsecond", errorOf(res).Error())
+}
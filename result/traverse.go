@@ -0,0 +1,80 @@
+package result
+
+import (
+	"fmt"
+
+	"github.com/MisterKaiou/go-functional/internal/core"
+)
+
+// IndexError is the error held by the result of Traverse, TraverseIndexed and Sequence when an element fails. It
+// reports the index of that element and wraps its error.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// KeyError is the error held by the result of TraverseMap when an entry fails. It reports the key of that entry and
+// wraps its error.
+type KeyError[K comparable] struct {
+	Key K
+	Err error
+}
+
+func (e *KeyError[K]) Error() string {
+	return fmt.Sprintf("key %v: %v", e.Key, e.Err)
+}
+
+func (e *KeyError[K]) Unwrap() error {
+	return e.Err
+}
+
+// Traverse applies the function to every element of the slice, in order, and returns Ok with the values it produced.
+// It stops at the first error, returning it wrapped in an *IndexError.
+func Traverse[T, To any](items []T, f func(T) Of[To]) Of[[]To] {
+	return TraverseIndexed(items, func(_ int, it T) Of[To] { return f(it) })
+}
+
+// TraverseIndexed works like Traverse, but the function also receives the index of the element.
+func TraverseIndexed[T, To any](items []T, f func(int, T) Of[To]) Of[[]To] {
+	values := make([]To, 0, len(items))
+	for i, it := range items {
+		value, err := core.Unpack(f(i, it))
+		if err != nil {
+			return Error[[]To](&IndexError{Index: i, Err: err})
+		}
+
+		values = append(values, value)
+	}
+
+	return Ok(values)
+}
+
+// TraverseMap applies the function to every value of the map and returns Ok with a map holding the values it produced
+// under the same keys. It stops at the first error, returning it wrapped in a *KeyError. Since maps are not ordered,
+// which entry fails first is not deterministic when several of them would.
+func TraverseMap[K comparable, T, To any](items map[K]T, f func(T) Of[To]) Of[map[K]To] {
+	values := make(map[K]To, len(items))
+	for k, it := range items {
+		value, err := core.Unpack(f(it))
+		if err != nil {
+			return Error[map[K]To](&KeyError[K]{Key: k, Err: err})
+		}
+
+		values[k] = value
+	}
+
+	return Ok(values)
+}
+
+// Sequence turns a slice of Of into an Of of a slice. It returns the first error found, wrapped in an *IndexError.
+func Sequence[T any](items []Of[T]) Of[[]T] {
+	return Traverse(items, func(it Of[T]) Of[T] { return it })
+}
@@ -0,0 +1,81 @@
+package result
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func parse(s string) Of[int] {
+	return FromTupleOf(strconv.Atoi(s))
+}
+
+func TestTraverse(t *testing.T) {
+	assert.Equal(t, Ok([]int{1, 2, 3}), Traverse([]string{"1", "2", "3"}, parse))
+	assert.Equal(t, Ok([]int{}), Traverse([]string{}, parse))
+}
+
+func TestTraverseWithError(t *testing.T) {
+	calls := 0
+	counted := func(s string) Of[int] { calls++; return parse(s) }
+
+	res := Traverse([]string{"1", "two", "three"}, counted)
+
+	var indexErr *IndexError
+	assert.ErrorAs(t, errorOf(res), &indexErr)
+	assert.Equal(t, 1, indexErr.Index)
+	assert.ErrorIs(t, errorOf(res), strconv.ErrSyntax)
+	assert.Equal(t, `index 1: strconv.Atoi: parsing "two": invalid syntax`, errorOf(res).Error())
+	assert.Equal(t, 2, calls)
+}
+
+func TestTraverseIndexed(t *testing.T) {
+	err := errors.New("odd position")
+	evenOnly := func(i int, s string) Of[string] {
+		if i%2 != 0 {
+			return Error[string](err)
+		}
+
+		return Ok(strconv.Itoa(i) + s)
+	}
+
+	assert.Equal(t, Ok([]string{"0a"}), TraverseIndexed([]string{"a"}, evenOnly))
+
+	res := TraverseIndexed([]string{"a", "b"}, evenOnly)
+
+	var indexErr *IndexError
+	assert.ErrorAs(t, errorOf(res), &indexErr)
+	assert.Equal(t, 1, indexErr.Index)
+	assert.Same(t, err, indexErr.Err)
+}
+
+func TestTraverseMap(t *testing.T) {
+	res := TraverseMap(map[string]string{"a": "1", "b": "2"}, parse)
+
+	assert.Equal(t, Ok(map[string]int{"a": 1, "b": 2}), res)
+}
+
+func TestTraverseMapWithError(t *testing.T) {
+	res := TraverseMap(map[string]string{"a": "1", "b": "two"}, parse)
+
+	var keyErr *KeyError[string]
+	assert.ErrorAs(t, errorOf(res), &keyErr)
+	assert.Equal(t, "b", keyErr.Key)
+	assert.ErrorIs(t, errorOf(res), strconv.ErrSyntax)
+	assert.Equal(t, `key b: strconv.Atoi: parsing "two": invalid syntax`, errorOf(res).Error())
+}
+
+func TestSequence(t *testing.T) {
+	err := errors.New("error")
+
+	assert.Equal(t, Ok([]int{1, 2}), Sequence([]Of[int]{Ok(1), Ok(2)}))
+
+	res := Sequence([]Of[int]{Ok(1), Ok(2), Error[int](err), Error[int](errors.New("other"))})
+
+	var indexErr *IndexError
+	assert.ErrorAs(t, errorOf(res), &indexErr)
+	assert.Equal(t, 2, indexErr.Index)
+	assert.ErrorIs(t, errorOf(res), err)
+}
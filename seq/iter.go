@@ -0,0 +1,38 @@
+package seq
+
+import (
+	"iter"
+
+	"github.com/MisterKaiou/go-functional/option"
+)
+
+// All returns an iterator that pulls and yields the values of this Of.
+func (s Of[T]) All() iter.Seq[T] {
+	return All(s)
+}
+
+// All returns an iterator that pulls and yields the values of the given Of. Values are pulled one at a time, so
+// breaking out of the loop leaves the rest of the sequence untouched.
+func All[T any](s Of[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for next := s.Next(); next.IsSome(); next = s.Next() {
+			if !yield(next.Unwrap()) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq creates a new Of that pulls its values from the given iterator, along with a function that stops it. As with
+// iter.Pull, stop must be called if the returned Of is not pulled until it is exhausted.
+func FromSeq[T any](seq iter.Seq[T]) (Of[T], func()) {
+	next, stop := iter.Pull(seq)
+	return New(func() option.Of[T] {
+		it, ok := next()
+		if !ok {
+			return option.None[T]()
+		}
+
+		return option.Some(it)
+	}), stop
+}
@@ -0,0 +1,43 @@
+package seq
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/MisterKaiou/go-functional/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAll(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(FromSlice([]int{1, 2, 3}).All()))
+	assert.Empty(t, slices.Collect(All(Empty[int]())))
+}
+
+func TestAllEarlyStop(t *testing.T) {
+	pulled := 0
+	s := naturals(&pulled)
+
+	for it := range All(s) {
+		if it == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, 3, pulled)
+	assert.Equal(t, option.Some(3), s.Next())
+}
+
+func TestFromSeq(t *testing.T) {
+	s, stop := FromSeq(slices.Values([]int{1, 2, 3}))
+	defer stop()
+
+	assert.Equal(t, []int{2, 4, 6}, ToSlice(Map(s, func(it int) int { return it * 2 })))
+}
+
+func TestFromSeqStop(t *testing.T) {
+	s, stop := FromSeq(slices.Values([]int{1, 2, 3}))
+
+	assert.Equal(t, option.Some(1), s.Next())
+	stop()
+	assert.Equal(t, option.None[int](), s.Next())
+}
@@ -0,0 +1,160 @@
+package seq
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/MisterKaiou/go-functional/option"
+	"github.com/MisterKaiou/go-functional/result"
+)
+
+// Result represents a sequence of result.Of[T], such as records that are read and parsed one at a time. Each failed
+// record is an error value of the sequence, so the ones after it can still be pulled.
+type Result[T any] = Of[result.Of[T]]
+
+// Lift creates a new Result that produces Ok holding every value of s.
+func Lift[T any](s Of[T]) Result[T] {
+	return Map(s, result.Ok[T])
+}
+
+// MapOk creates a new Result that applies the mapping function on every Ok value of s. Errors are produced as they are.
+func MapOk[T, To any](s Result[T], mapping func(T) To) Result[To] {
+	return Map(s, func(res result.Of[T]) result.Of[To] { return result.Map(res, mapping) })
+}
+
+// BindOk creates a new Result that passes every Ok value of s to the binding function and produces the result it
+// returns. Errors are produced as they are, without calling the binding function.
+func BindOk[T, To any](s Result[T], binding func(T) result.Of[To]) Result[To] {
+	return Map(s, func(res result.Of[T]) result.Of[To] { return result.Bind(res, binding) })
+}
+
+// StopOnError creates a new Result that produces the values of s up to, and including, its first error. s is not
+// pulled past that error.
+func StopOnError[T any](s Result[T]) Result[T] {
+	done := false
+	return New(func() option.Of[result.Of[T]] {
+		if done {
+			return option.None[result.Of[T]]()
+		}
+
+		next := s.Next()
+		done = option.Exists(next, result.IsError[T])
+		return next
+	})
+}
+
+// SkipErrors creates a new Of that produces the Ok values of s, discarding its errors.
+func SkipErrors[T any](s Result[T]) Of[T] {
+	return New(func() option.Of[T] {
+		for {
+			next := s.Next()
+			if next.IsNone() {
+				return option.None[T]()
+			}
+
+			if res := next.Unwrap(); res.IsOk() {
+				return option.Some(res.Unwrap())
+			}
+		}
+	})
+}
+
+// Collect pulls the values of s until its first error and returns it. If s has no errors, it returns Ok with all of its
+// values.
+func Collect[T any](s Result[T]) result.Of[[]T] {
+	items := []T{}
+	for next := s.Next(); next.IsSome(); next = s.Next() {
+		res := next.Unwrap()
+		if res.IsError() {
+			return result.Error[[]T](res.UnwrapError())
+		}
+
+		items = append(items, res.Unwrap())
+	}
+
+	return result.Ok(items)
+}
+
+// CollectErrors pulls every value of s and returns its errors, in order.
+func CollectErrors[T any](s Result[T]) []error {
+	return Fold(s, []error{}, func(errs []error, res result.Of[T]) []error {
+		if res.IsError() {
+			return append(errs, res.UnwrapError())
+		}
+
+		return errs
+	})
+}
+
+// FromReaderLines creates a new Result that produces the lines read from r, without their line endings. If reading
+// fails, the error is produced as the last value.
+func FromReaderLines(r io.Reader) Result[string] {
+	scanner := bufio.NewScanner(r)
+	return fromRead(
+		func() (string, error) {
+			if scanner.Scan() {
+				return scanner.Text(), nil
+			}
+
+			if err := scanner.Err(); err != nil {
+				return "", err
+			}
+
+			return "", io.EOF
+		},
+		func(error) bool { return false },
+	)
+}
+
+// FromCSV creates a new Result that produces the records read from r. A record that cannot be parsed is produced as an
+// error holding the *csv.ParseError, and reading goes on with the next one. Any other error is produced as the last
+// value.
+func FromCSV(r *csv.Reader) Result[[]string] {
+	return fromRead(r.Read, func(err error) bool {
+		var parseErr *csv.ParseError
+		return errors.As(err, &parseErr)
+	})
+}
+
+// FromJSONDecoder creates a new Result that decodes the values of d into T, one at a time. A value that does not fit
+// T is produced as an error holding the *json.UnmarshalTypeError, and decoding goes on with the next one. Any other
+// error, such as malformed JSON, is produced as the last value.
+func FromJSONDecoder[T any](d *json.Decoder) Result[T] {
+	return fromRead(
+		func() (T, error) {
+			var it T
+			err := d.Decode(&it)
+			return it, err
+		},
+		func(err error) bool {
+			var typeErr *json.UnmarshalTypeError
+			return errors.As(err, &typeErr)
+		},
+	)
+}
+
+// fromRead creates a new Result that calls read until it returns io.EOF. Errors for which recoverable returns false
+// end the sequence after being produced.
+func fromRead[T any](read func() (T, error), recoverable func(error) bool) Result[T] {
+	done := false
+	return New(func() option.Of[result.Of[T]] {
+		if done {
+			return option.None[result.Of[T]]()
+		}
+
+		it, err := read()
+		switch {
+		case err == io.EOF:
+			done = true
+			return option.None[result.Of[T]]()
+		case err != nil:
+			done = !recoverable(err)
+			return option.Some(result.Error[T](err))
+		default:
+			return option.Some(result.Ok(it))
+		}
+	})
+}
@@ -0,0 +1,124 @@
+package seq
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/MisterKaiou/go-functional/option"
+	"github.com/MisterKaiou/go-functional/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func parseAll(items ...string) Result[int] {
+	return Map(FromSlice(items), func(it string) result.Of[int] { return result.FromTupleOf(strconv.Atoi(it)) })
+}
+
+func TestLift(t *testing.T) {
+	assert.Equal(t, []result.Of[int]{result.Ok(1), result.Ok(2)}, ToSlice(Lift(FromSlice([]int{1, 2}))))
+}
+
+func TestMapOk(t *testing.T) {
+	s := MapOk(parseAll("1", "x", "3"), func(it int) int { return it * 10 })
+
+	values := ToSlice(s)
+
+	assert.Len(t, values, 3)
+	assert.Equal(t, result.Ok(10), values[0])
+	assert.True(t, result.IsError(values[1]))
+	assert.Equal(t, result.Ok(30), values[2])
+}
+
+func TestBindOk(t *testing.T) {
+	errNegative := errors.New("negative")
+	s := BindOk(parseAll("1", "-2"), func(it int) result.Of[int] {
+		if it < 0 {
+			return result.Error[int](errNegative)
+		}
+
+		return result.Ok(it)
+	})
+
+	assert.Equal(t, []result.Of[int]{result.Ok(1), result.Error[int](errNegative)}, ToSlice(s))
+}
+
+func TestStopOnError(t *testing.T) {
+	pulled := 0
+	source := Map(FromSlice([]string{"1", "x", "3"}), func(it string) string {
+		pulled++
+		return it
+	})
+
+	values := ToSlice(StopOnError(Map(source, func(it string) result.Of[int] {
+		return result.FromTupleOf(strconv.Atoi(it))
+	})))
+
+	assert.Len(t, values, 2)
+	assert.True(t, result.IsError(values[1]))
+	assert.Equal(t, 2, pulled)
+}
+
+func TestSkipErrors(t *testing.T) {
+	assert.Equal(t, []int{1, 3}, ToSlice(SkipErrors(parseAll("1", "x", "3", "y"))))
+}
+
+func TestCollect(t *testing.T) {
+	assert.Equal(t, result.Ok([]int{1, 2}), Collect(parseAll("1", "2")))
+	assert.True(t, result.IsError(Collect(parseAll("1", "x"))))
+}
+
+func TestCollectErrors(t *testing.T) {
+	assert.Len(t, CollectErrors(parseAll("1", "x", "3", "y")), 2)
+	assert.Empty(t, CollectErrors(parseAll("1")))
+}
+
+func TestFromReaderLines(t *testing.T) {
+	s := FromReaderLines(strings.NewReader("first\r\nsecond\nthird"))
+
+	assert.Equal(t, result.Ok([]string{"first", "second", "third"}), Collect(s))
+}
+
+func TestFromReaderLinesError(t *testing.T) {
+	errRead := errors.New("read failed")
+	s := FromReaderLines(iotest.TimeoutReader(strings.NewReader("first\n")))
+
+	assert.Equal(t, option.Some(result.Ok("first")), s.Next())
+	assert.Equal(t, option.Some(result.Error[string](iotest.ErrTimeout)), s.Next())
+	assert.Equal(t, option.None[result.Of[string]](), s.Next())
+
+	s = FromReaderLines(iotest.ErrReader(errRead))
+
+	assert.Equal(t, []error{errRead}, CollectErrors(s))
+}
+
+func TestFromCSV(t *testing.T) {
+	s := FromCSV(csv.NewReader(strings.NewReader("a,b\nc,\"d\"e\nf,g\n")))
+
+	values := ToSlice(s)
+
+	assert.Len(t, values, 3)
+	assert.Equal(t, result.Ok([]string{"a", "b"}), values[0])
+	assert.ErrorIs(t, values[1].UnwrapError(), csv.ErrQuote)
+	assert.Equal(t, result.Ok([]string{"f", "g"}), values[2])
+}
+
+func TestFromJSONDecoder(t *testing.T) {
+	type record struct {
+		ID int `json:"id"`
+	}
+	s := FromJSONDecoder[record](json.NewDecoder(strings.NewReader(`{"id": 1} {"id": "two"} {"id": 3} {`)))
+
+	values := ToSlice(s)
+
+	assert.Len(t, values, 4)
+	assert.Equal(t, result.Ok(record{1}), values[0])
+
+	var typeErr *json.UnmarshalTypeError
+	assert.ErrorAs(t, values[1].UnwrapError(), &typeErr)
+	assert.Equal(t, result.Ok(record{3}), values[2])
+	assert.True(t, result.IsError(values[3]))
+}
@@ -0,0 +1,249 @@
+// Package seq provides lazy, pull-based sequences. Nothing is computed until a value is pulled with Next, so
+// sequences can be chained, or even be infinite, without materialising any intermediate slices.
+package seq
+
+import (
+	"slices"
+
+	"github.com/MisterKaiou/go-functional/option"
+	"github.com/MisterKaiou/go-functional/tuple"
+)
+
+// Of represents a lazy sequence of values of type T. Each call to Next produces the next value as Some, or None once
+// the sequence is exhausted.
+//
+// An Of is consumed as it is pulled: values read through it, or through any sequence built from it, are not produced
+// again. The zero value of Of is an empty sequence.
+type Of[T any] struct {
+	next func() option.Of[T]
+}
+
+// Next pulls the next value of this Of. It returns None once the sequence is exhausted.
+func (s Of[T]) Next() option.Of[T] {
+	if s.next == nil {
+		return option.None[T]()
+	}
+
+	return s.next()
+}
+
+// New creates a new Of that calls next every time a value is pulled from it. next should keep returning None once it
+// has returned None.
+func New[T any](next func() option.Of[T]) Of[T] {
+	return Of[T]{next: next}
+}
+
+// Empty creates a new Of that produces nothing.
+func Empty[T any]() Of[T] {
+	return Of[T]{}
+}
+
+// FromSlice creates a new Of that produces the items of the given slice, in order.
+func FromSlice[T any](items []T) Of[T] {
+	index := 0
+	return New(func() option.Of[T] {
+		if index >= len(items) {
+			return option.None[T]()
+		}
+
+		index++
+		return option.Some(items[index-1])
+	})
+}
+
+// Unfold creates a new Of from a seed. f is called with the current state and returns Some with the value to produce
+// and the next state, or None to end the sequence.
+func Unfold[T, S any](seed S, f func(S) option.Of[tuple.Pair[T, S]]) Of[T] {
+	state := seed
+	done := false
+	return New(func() option.Of[T] {
+		if done {
+			return option.None[T]()
+		}
+
+		next := f(state)
+		if next.IsNone() {
+			done = true
+			return option.None[T]()
+		}
+
+		it, nextState := next.Unwrap().Unpack()
+		state = nextState
+		return option.Some(it)
+	})
+}
+
+// ToSlice pulls every value of the given Of and returns them in a slice. It never returns if the sequence is infinite.
+func ToSlice[T any](s Of[T]) []T {
+	return Fold(s, []T{}, func(items []T, it T) []T { return append(items, it) })
+}
+
+// Fold pulls every value of the given Of and applies the folder function to each of them, threading the state
+// through. It returns the final state.
+func Fold[T, State any](s Of[T], state State, folder func(State, T) State) State {
+	for next := s.Next(); next.IsSome(); next = s.Next() {
+		state = folder(state, next.Unwrap())
+	}
+
+	return state
+}
+
+// Scan creates a new Of that works like Fold, but produces the state after each value is folded instead of only the
+// final one.
+func Scan[T, State any](s Of[T], state State, folder func(State, T) State) Of[State] {
+	return New(func() option.Of[State] {
+		return option.Map(s.Next(), func(it T) State {
+			state = folder(state, it)
+			return state
+		})
+	})
+}
+
+// Map creates a new Of that applies the mapping function to every value of s.
+func Map[T, To any](s Of[T], mapping func(T) To) Of[To] {
+	return New(func() option.Of[To] {
+		return option.Map(s.Next(), mapping)
+	})
+}
+
+// Filter creates a new Of that only produces the values of s that satisfy the predicate.
+func Filter[T any](s Of[T], predicate func(T) bool) Of[T] {
+	return New(func() option.Of[T] {
+		for {
+			next := s.Next()
+			if next.IsNone() || predicate(next.Unwrap()) {
+				return next
+			}
+		}
+	})
+}
+
+// FlatMap creates a new Of that applies the binding function to every value of s, and produces every value of the
+// sequences it returns, in order.
+func FlatMap[T, To any](s Of[T], binding func(T) Of[To]) Of[To] {
+	var current Of[To]
+	return New(func() option.Of[To] {
+		for {
+			if next := current.Next(); next.IsSome() {
+				return next
+			}
+
+			outer := s.Next()
+			if outer.IsNone() {
+				return option.None[To]()
+			}
+
+			current = binding(outer.Unwrap())
+		}
+	})
+}
+
+// Take creates a new Of that produces at most the first n values of s.
+func Take[T any](s Of[T], n int) Of[T] {
+	return New(func() option.Of[T] {
+		if n <= 0 {
+			return option.None[T]()
+		}
+
+		n--
+		return s.Next()
+	})
+}
+
+// Drop creates a new Of that skips the first n values of s and produces the remaining ones. The values are only
+// skipped when the first value is pulled.
+func Drop[T any](s Of[T], n int) Of[T] {
+	return New(func() option.Of[T] {
+		for ; n > 0; n-- {
+			if option.IsNone(s.Next()) {
+				n = 0
+				return option.None[T]()
+			}
+		}
+
+		return s.Next()
+	})
+}
+
+// TakeWhile creates a new Of that produces the values of s until one of them does not satisfy the predicate. That value
+// is pulled from s, but not produced.
+func TakeWhile[T any](s Of[T], predicate func(T) bool) Of[T] {
+	done := false
+	return New(func() option.Of[T] {
+		if done {
+			return option.None[T]()
+		}
+
+		next := s.Next()
+		if next.IsNone() || !predicate(next.Unwrap()) {
+			done = true
+			return option.None[T]()
+		}
+
+		return next
+	})
+}
+
+// Zip creates a new Of that pairs the values of a and b by position. It ends as soon as either of them is exhausted; b is
+// not pulled once a is.
+func Zip[A, B any](a Of[A], b Of[B]) Of[tuple.Pair[A, B]] {
+	return New(func() option.Of[tuple.Pair[A, B]] {
+		first := a.Next()
+		if first.IsNone() {
+			return option.None[tuple.Pair[A, B]]()
+		}
+
+		return option.Zip(first, b.Next())
+	})
+}
+
+// Chunk creates a new Of that groups the values of s in slices of the given size. The last slice holds the remaining
+// values and may be shorter. It panics if size is less than 1.
+func Chunk[T any](s Of[T], size int) Of[[]T] {
+	if size < 1 {
+		panic("cannot chunk a sequence with a size less than 1")
+	}
+
+	return New(func() option.Of[[]T] {
+		chunk := make([]T, 0, size)
+		for len(chunk) < size {
+			next := s.Next()
+			if next.IsNone() {
+				break
+			}
+
+			chunk = append(chunk, next.Unwrap())
+		}
+
+		if len(chunk) == 0 {
+			return option.None[[]T]()
+		}
+
+		return option.Some(chunk)
+	})
+}
+
+// Window creates a new Of that produces every run of size consecutive values of s, sliding by one value at a time.
+// Nothing is produced if s has less than size values. Each produced slice is a new one. It panics if size is less
+// than 1.
+func Window[T any](s Of[T], size int) Of[[]T] {
+	if size < 1 {
+		panic("cannot window a sequence with a size less than 1")
+	}
+
+	window := make([]T, 0, size)
+	return New(func() option.Of[[]T] {
+		for len(window) < size {
+			next := s.Next()
+			if next.IsNone() {
+				return option.None[[]T]()
+			}
+
+			window = append(window, next.Unwrap())
+		}
+
+		out := slices.Clone(window)
+		window = append(window[:0], window[1:]...)
+		return option.Some(out)
+	})
+}
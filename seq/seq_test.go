@@ -0,0 +1,146 @@
+package seq
+
+import (
+	"testing"
+
+	"github.com/MisterKaiou/go-functional/option"
+	"github.com/MisterKaiou/go-functional/tuple"
+	"github.com/stretchr/testify/assert"
+)
+
+// naturals returns an infinite sequence of the natural numbers, counting how many were pulled in pulled.
+func naturals(pulled *int) Of[int] {
+	return Unfold(0, func(it int) option.Of[tuple.Pair[int, int]] {
+		*pulled++
+		return option.Some(tuple.NewPair(it, it+1))
+	})
+}
+
+func TestZeroValue(t *testing.T) {
+	var s Of[int]
+
+	assert.Equal(t, option.None[int](), s.Next())
+	assert.Empty(t, ToSlice(Empty[int]()))
+}
+
+func TestFromSlice(t *testing.T) {
+	s := FromSlice([]int{1, 2})
+
+	assert.Equal(t, option.Some(1), s.Next())
+	assert.Equal(t, option.Some(2), s.Next())
+	assert.Equal(t, option.None[int](), s.Next())
+	assert.Equal(t, option.None[int](), s.Next())
+}
+
+func TestUnfold(t *testing.T) {
+	s := Unfold(3, func(it int) option.Of[tuple.Pair[int, int]] {
+		if it == 0 {
+			return option.None[tuple.Pair[int, int]]()
+		}
+
+		return option.Some(tuple.NewPair(it*10, it-1))
+	})
+
+	assert.Equal(t, []int{30, 20, 10}, ToSlice(s))
+}
+
+func TestMapIsLazy(t *testing.T) {
+	pulled := 0
+	mapped := 0
+
+	s := Map(naturals(&pulled), func(it int) int {
+		mapped++
+		return it * 2
+	})
+
+	assert.Equal(t, 0, pulled)
+	assert.Equal(t, 0, mapped)
+
+	assert.Equal(t, []int{0, 2, 4}, ToSlice(Take(s, 3)))
+	assert.Equal(t, 3, pulled)
+	assert.Equal(t, 3, mapped)
+}
+
+func TestFilter(t *testing.T) {
+	even := Filter(FromSlice([]int{1, 2, 3, 4, 5}), func(it int) bool { return it%2 == 0 })
+
+	assert.Equal(t, []int{2, 4}, ToSlice(even))
+}
+
+func TestFlatMap(t *testing.T) {
+	s := FlatMap(FromSlice([]int{1, 0, 2}), func(it int) Of[int] {
+		return Take(Unfold(it, func(s int) option.Of[tuple.Pair[int, int]] {
+			return option.Some(tuple.NewPair(s, s))
+		}), it)
+	})
+
+	assert.Equal(t, []int{1, 2, 2}, ToSlice(s))
+}
+
+func TestTake(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, ToSlice(Take(FromSlice([]int{1, 2, 3}), 2)))
+	assert.Equal(t, []int{1}, ToSlice(Take(FromSlice([]int{1}), 2)))
+	assert.Empty(t, ToSlice(Take(FromSlice([]int{1}), -1)))
+}
+
+func TestDrop(t *testing.T) {
+	assert.Equal(t, []int{3}, ToSlice(Drop(FromSlice([]int{1, 2, 3}), 2)))
+	assert.Empty(t, ToSlice(Drop(FromSlice([]int{1, 2}), 3)))
+	assert.Equal(t, []int{1}, ToSlice(Drop(FromSlice([]int{1}), 0)))
+}
+
+func TestTakeWhile(t *testing.T) {
+	pulled := 0
+
+	s := TakeWhile(naturals(&pulled), func(it int) bool { return it < 3 })
+
+	assert.Equal(t, []int{0, 1, 2}, ToSlice(s))
+	assert.Equal(t, 4, pulled)
+	assert.Equal(t, option.None[int](), s.Next())
+	assert.Equal(t, 4, pulled)
+}
+
+func TestZip(t *testing.T) {
+	pulled := 0
+
+	s := Zip(FromSlice([]string{"a", "b"}), naturals(&pulled))
+
+	assert.Equal(t, []tuple.Pair[string, int]{tuple.NewPair("a", 0), tuple.NewPair("b", 1)}, ToSlice(s))
+	assert.Equal(t, 2, pulled)
+}
+
+func TestChunk(t *testing.T) {
+	s := Chunk(FromSlice([]int{1, 2, 3, 4, 5}), 2)
+
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, ToSlice(s))
+	assert.Panics(t, func() { Chunk(Empty[int](), 0) })
+}
+
+func TestWindow(t *testing.T) {
+	s := Window(FromSlice([]int{1, 2, 3, 4}), 3)
+
+	assert.Equal(t, [][]int{{1, 2, 3}, {2, 3, 4}}, ToSlice(s))
+	assert.Empty(t, ToSlice(Window(FromSlice([]int{1, 2}), 3)))
+	assert.Panics(t, func() { Window(Empty[int](), 0) })
+}
+
+func TestWindowReturnsNewSlices(t *testing.T) {
+	s := Window(FromSlice([]int{1, 2, 3}), 2)
+
+	first, second := s.Next(), s.Next()
+
+	assert.Equal(t, option.Some([]int{1, 2}), first)
+	assert.Equal(t, option.Some([]int{2, 3}), second)
+}
+
+func TestScan(t *testing.T) {
+	s := Scan(FromSlice([]int{1, 2, 3}), 10, func(sum int, it int) int { return sum + it })
+
+	assert.Equal(t, []int{11, 13, 16}, ToSlice(s))
+}
+
+func TestFold(t *testing.T) {
+	sum := Fold(FromSlice([]int{1, 2, 3}), 10, func(sum int, it int) int { return sum + it })
+
+	assert.Equal(t, 16, sum)
+}
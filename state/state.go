@@ -0,0 +1,124 @@
+package state
+
+import (
+	"github.com/MisterKaiou/go-functional/result"
+	"github.com/MisterKaiou/go-functional/unit"
+)
+
+// Of represents a computation that, given a state of type S, produces a value of type A and a new state.
+//
+// The zero value of Of produces the zero value of A and leaves the state untouched.
+type Of[S, A any] struct {
+	run func(S) (A, S)
+}
+
+// Result represents a computation on a state of type S that produces a result.Of[A]. Functions like BindOk stop at the
+// first error, keeping the state as it was when the error happened.
+type Result[S, A any] = Of[S, result.Of[A]]
+
+// New creates a new Of from a function that receives the current state and returns a value and the new state.
+func New[S, A any](f func(S) (A, S)) Of[S, A] {
+	return Of[S, A]{run: f}
+}
+
+// Pure creates a new Of that produces the given value and leaves the state untouched.
+func Pure[S, A any](it A) Of[S, A] {
+	return New(func(s S) (A, S) { return it, s })
+}
+
+// Get creates a new Of that produces the current state.
+func Get[S any]() Of[S, S] {
+	return New(func(s S) (S, S) { return s, s })
+}
+
+// Gets creates a new Of that produces the result of applying f to the current state.
+func Gets[S, A any](f func(S) A) Of[S, A] {
+	return New(func(s S) (A, S) { return f(s), s })
+}
+
+// Put creates a new Of that replaces the current state with the given one.
+func Put[S any](s S) Of[S, unit.Unit] {
+	return New(func(S) (unit.Unit, S) { return unit.Unit{}, s })
+}
+
+// Modify creates a new Of that replaces the current state with the result of applying f to it.
+func Modify[S any](f func(S) S) Of[S, unit.Unit] {
+	return New(func(s S) (unit.Unit, S) { return unit.Unit{}, f(s) })
+}
+
+// Map creates a new Of that applies the mapping function on the value produced by m.
+func Map[S, A, To any](m Of[S, A], mapping func(A) To) Of[S, To] {
+	return New(func(s S) (To, S) {
+		it, next := Run(m, s)
+		return mapping(it), next
+	})
+}
+
+// Bind creates a new Of that passes the value produced by m to the binding function and runs the Of it returns with
+// the state left by m.
+func Bind[S, A, To any](m Of[S, A], binding func(A) Of[S, To]) Of[S, To] {
+	return New(func(s S) (To, S) {
+		it, next := Run(m, s)
+		return Run(binding(it), next)
+	})
+}
+
+// Run executes m with the given initial state and returns the value it produces and the final state.
+func Run[S, A any](m Of[S, A], initial S) (A, S) {
+	if m.run == nil {
+		var zero A
+		return zero, initial
+	}
+
+	return m.run(initial)
+}
+
+// Eval executes m with the given initial state and returns only the value it produces.
+func Eval[S, A any](m Of[S, A], initial S) A {
+	it, _ := Run(m, initial)
+	return it
+}
+
+// Exec executes m with the given initial state and returns only the final state.
+func Exec[S, A any](m Of[S, A], initial S) S {
+	_, final := Run(m, initial)
+	return final
+}
+
+// Ok creates a new Result that produces Ok holding the given value and leaves the state untouched.
+func Ok[S, A any](it A) Result[S, A] {
+	return Pure[S](result.Ok(it))
+}
+
+// Error creates a new Result that produces an error result holding the given error and leaves the state untouched.
+func Error[S, A any](err error) Result[S, A] {
+	return Pure[S](result.Error[A](err))
+}
+
+// FromResult creates a new Result that produces the given result and leaves the state untouched.
+func FromResult[S, A any](res result.Of[A]) Result[S, A] {
+	return Pure[S](res)
+}
+
+// Lift creates a new Result that produces Ok holding the value produced by m.
+func Lift[S, A any](m Of[S, A]) Result[S, A] {
+	return Map(m, result.Ok[A])
+}
+
+// MapOk creates a new Result that applies the mapping function on the value produced by m, if it is Ok.
+func MapOk[S, A, To any](m Result[S, A], mapping func(A) To) Result[S, To] {
+	return Map(m, func(res result.Of[A]) result.Of[To] { return result.Map(res, mapping) })
+}
+
+// BindOk creates a new Result that, if m produces Ok, passes its value to the binding function and runs the Result it
+// returns. If m produces an error, the binding function is not called and the state is left as m left it.
+func BindOk[S, A, To any](m Result[S, A], binding func(A) Result[S, To]) Result[S, To] {
+	return New(func(s S) (result.Of[To], S) {
+		res, next := Run(m, s)
+		if res.IsError() {
+			return result.Error[To](res.UnwrapError()), next
+		}
+
+		return Run(binding(res.Unwrap()), next)
+	})
+}
@@ -0,0 +1,183 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/MisterKaiou/go-functional/result"
+	"github.com/MisterKaiou/go-functional/unit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZeroValue(t *testing.T) {
+	var m Of[string, int]
+
+	it, final := Run(m, "state")
+
+	assert.Equal(t, 0, it)
+	assert.Equal(t, "state", final)
+}
+
+func TestPure(t *testing.T) {
+	it, final := Run(Pure[int]("value"), 42)
+
+	assert.Equal(t, "value", it)
+	assert.Equal(t, 42, final)
+}
+
+func TestGet(t *testing.T) {
+	it, final := Run(Get[int](), 42)
+
+	assert.Equal(t, 42, it)
+	assert.Equal(t, 42, final)
+}
+
+func TestGets(t *testing.T) {
+	assert.Equal(t, "42", Eval(Gets(func(s int) string { return fmt.Sprint(s) }), 42))
+}
+
+func TestPut(t *testing.T) {
+	it, final := Run(Put(7), 42)
+
+	assert.Equal(t, unit.Unit{}, it)
+	assert.Equal(t, 7, final)
+}
+
+func TestModify(t *testing.T) {
+	assert.Equal(t, 43, Exec(Modify(func(s int) int { return s + 1 }), 42))
+}
+
+func TestMap(t *testing.T) {
+	m := Map(Get[int](), func(s int) string { return fmt.Sprint("state is ", s) })
+
+	it, final := Run(m, 42)
+
+	assert.Equal(t, "state is 42", it)
+	assert.Equal(t, 42, final)
+}
+
+func TestBind(t *testing.T) {
+	increment := Bind(Get[int](), func(s int) Of[int, int] {
+		return Bind(Put(s+1), func(unit.Unit) Of[int, int] { return Pure[int](s) })
+	})
+
+	it, final := Run(Bind(increment, func(int) Of[int, int] { return increment }), 0)
+
+	assert.Equal(t, 1, it)
+	assert.Equal(t, 2, final)
+}
+
+func TestEvalExec(t *testing.T) {
+	m := New(func(s []string) (int, []string) { return len(s), append(s, "next") })
+
+	assert.Equal(t, 1, Eval(m, []string{"first"}))
+	assert.Equal(t, []string{"first", "next"}, Exec(m, []string{"first"}))
+}
+
+func TestLongLeftNestedChain(t *testing.T) {
+	const steps = 100_000
+	m := Pure[int](0)
+	for i := 0; i < steps; i++ {
+		m = Bind(m, func(acc int) Of[int, int] {
+			return Map(Modify(func(s int) int { return s + 1 }), func(unit.Unit) int { return acc + 1 })
+		})
+	}
+
+	it, final := Run(m, 0)
+
+	assert.Equal(t, steps, it)
+	assert.Equal(t, steps, final)
+}
+
+func TestLongRecursiveChain(t *testing.T) {
+	const steps = 100_000
+	var countdown func(int) Of[int, int]
+	countdown = func(n int) Of[int, int] {
+		if n == 0 {
+			return Get[int]()
+		}
+
+		return Bind(Modify(func(s int) int { return s + n }), func(unit.Unit) Of[int, int] { return countdown(n - 1) })
+	}
+
+	assert.Equal(t, steps*(steps+1)/2, Eval(countdown(steps), 0))
+}
+
+func TestOkError(t *testing.T) {
+	err := errors.New("error")
+
+	ok, okState := Run(Ok[int]("value"), 42)
+	failed, failedState := Run(Error[int, string](err), 42)
+
+	assert.Equal(t, result.Ok("value"), ok)
+	assert.Equal(t, 42, okState)
+	assert.Equal(t, result.Error[string](err), failed)
+	assert.Equal(t, 42, failedState)
+}
+
+func TestFromResultAndLift(t *testing.T) {
+	assert.Equal(t, result.Ok(1), Eval(FromResult[int](result.Ok(1)), 0))
+	assert.Equal(t, result.Ok(42), Eval(Lift(Get[int]()), 42))
+}
+
+func TestMapOk(t *testing.T) {
+	err := errors.New("error")
+	double := func(it int) int { return it * 2 }
+
+	assert.Equal(t, result.Ok(84), Eval(MapOk(Lift(Get[int]()), double), 42))
+	assert.Equal(t, result.Error[int](err), Eval(MapOk(Error[int, int](err), double), 42))
+}
+
+// pop removes the first token of the state, failing if there is none.
+func pop() Result[[]string, string] {
+	return New(func(tokens []string) (result.Of[string], []string) {
+		if len(tokens) == 0 {
+			return result.Error[string](errors.New("unexpected end of input")), tokens
+		}
+
+		return result.Ok(tokens[0]), tokens[1:]
+	})
+}
+
+func TestBindOk(t *testing.T) {
+	pair := BindOk(pop(), func(key string) Result[[]string, string] {
+		return MapOk(pop(), func(value string) string { return key + "=" + value })
+	})
+
+	parsed, rest := Run(pair, []string{"a", "1", "b"})
+
+	assert.Equal(t, result.Ok("a=1"), parsed)
+	assert.Equal(t, []string{"b"}, rest)
+}
+
+func TestBindOkShortCircuits(t *testing.T) {
+	calls := 0
+	pair := BindOk(pop(), func(key string) Result[[]string, string] {
+		return BindOk(pop(), func(value string) Result[[]string, string] {
+			calls++
+			return Ok[[]string](key + "=" + value)
+		})
+	})
+
+	parsed, rest := Run(pair, []string{"a"})
+
+	assert.True(t, result.IsError(parsed))
+	assert.Empty(t, rest)
+	assert.Equal(t, 0, calls)
+}
+
+func TestBindOkLongChain(t *testing.T) {
+	const steps = 10_000
+	m := Ok[int](0)
+	for i := 0; i < steps; i++ {
+		m = BindOk(m, func(acc int) Result[int, int] {
+			return Lift(Map(Modify(func(s int) int { return s + 1 }), func(unit.Unit) int { return acc + 1 }))
+		})
+	}
+
+	it, final := Run(m, 0)
+
+	assert.Equal(t, result.Ok(steps), it)
+	assert.Equal(t, steps, final)
+}
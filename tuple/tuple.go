@@ -0,0 +1,155 @@
+package tuple
+
+import "fmt"
+
+// Pair holds two values of possibly different types.
+type Pair[A, B any] struct {
+	first  A
+	second B
+}
+
+// Triple holds three values of possibly different types.
+type Triple[A, B, C any] struct {
+	first  A
+	second B
+	third  C
+}
+
+// Tuple4 holds four values of possibly different types.
+type Tuple4[A, B, C, D any] struct {
+	first  A
+	second B
+	third  C
+	fourth D
+}
+
+// Tuple5 holds five values of possibly different types.
+type Tuple5[A, B, C, D, E any] struct {
+	first  A
+	second B
+	third  C
+	fourth D
+	fifth  E
+}
+
+// NewPair creates a new Pair holding the given values.
+func NewPair[A, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{a, b}
+}
+
+// NewTriple creates a new Triple holding the given values.
+func NewTriple[A, B, C any](a A, b B, c C) Triple[A, B, C] {
+	return Triple[A, B, C]{a, b, c}
+}
+
+// NewTuple4 creates a new Tuple4 holding the given values.
+func NewTuple4[A, B, C, D any](a A, b B, c C, d D) Tuple4[A, B, C, D] {
+	return Tuple4[A, B, C, D]{a, b, c, d}
+}
+
+// NewTuple5 creates a new Tuple5 holding the given values.
+func NewTuple5[A, B, C, D, E any](a A, b B, c C, d D, e E) Tuple5[A, B, C, D, E] {
+	return Tuple5[A, B, C, D, E]{a, b, c, d, e}
+}
+
+// The value returned when calling this method is "(a, b)", where a and b are fmt.Sprint applied to each value.
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.first, p.second)
+}
+
+func (p Pair[A, B]) First() A {
+	return p.first
+}
+
+func (p Pair[A, B]) Second() B {
+	return p.second
+}
+
+// Unpack returns the values of this Pair, in order.
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.first, p.second
+}
+
+// The value returned when calling this method is "(a, b, c)", where a, b and c are fmt.Sprint applied to each value.
+func (t Triple[A, B, C]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", t.first, t.second, t.third)
+}
+
+func (t Triple[A, B, C]) First() A {
+	return t.first
+}
+
+func (t Triple[A, B, C]) Second() B {
+	return t.second
+}
+
+func (t Triple[A, B, C]) Third() C {
+	return t.third
+}
+
+// Unpack returns the values of this Triple, in order.
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.first, t.second, t.third
+}
+
+// The value returned when calling this method is "(a, b, c, d)", where each one is fmt.Sprint applied to each value.
+func (t Tuple4[A, B, C, D]) String() string {
+	return fmt.Sprintf("(%v, %v, %v, %v)", t.first, t.second, t.third, t.fourth)
+}
+
+func (t Tuple4[A, B, C, D]) First() A {
+	return t.first
+}
+
+func (t Tuple4[A, B, C, D]) Second() B {
+	return t.second
+}
+
+func (t Tuple4[A, B, C, D]) Third() C {
+	return t.third
+}
+
+func (t Tuple4[A, B, C, D]) Fourth() D {
+	return t.fourth
+}
+
+// Unpack returns the values of this Tuple4, in order.
+func (t Tuple4[A, B, C, D]) Unpack() (A, B, C, D) {
+	return t.first, t.second, t.third, t.fourth
+}
+
+// The value returned when calling this method is "(a, b, c, d, e)", where each one is fmt.Sprint applied to each
+// value.
+func (t Tuple5[A, B, C, D, E]) String() string {
+	return fmt.Sprintf("(%v, %v, %v, %v, %v)", t.first, t.second, t.third, t.fourth, t.fifth)
+}
+
+func (t Tuple5[A, B, C, D, E]) First() A {
+	return t.first
+}
+
+func (t Tuple5[A, B, C, D, E]) Second() B {
+	return t.second
+}
+
+func (t Tuple5[A, B, C, D, E]) Third() C {
+	return t.third
+}
+
+func (t Tuple5[A, B, C, D, E]) Fourth() D {
+	return t.fourth
+}
+
+func (t Tuple5[A, B, C, D, E]) Fifth() E {
+	return t.fifth
+}
+
+// Unpack returns the values of this Tuple5, in order.
+func (t Tuple5[A, B, C, D, E]) Unpack() (A, B, C, D, E) {
+	return t.first, t.second, t.third, t.fourth, t.fifth
+}
+
+// Swap returns a new Pair with the values of the given one in reverse order.
+func Swap[A, B any](p Pair[A, B]) Pair[B, A] {
+	return NewPair(p.second, p.first)
+}
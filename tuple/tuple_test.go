@@ -0,0 +1,63 @@
+package tuple
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPair(t *testing.T) {
+	p := NewPair("a", 1)
+	a, b := p.Unpack()
+
+	assert.Equal(t, "a", p.First())
+	assert.Equal(t, 1, p.Second())
+	assert.Equal(t, "a", a)
+	assert.Equal(t, 1, b)
+	assert.Equal(t, "(a, 1)", p.String())
+}
+
+func TestTriple(t *testing.T) {
+	tr := NewTriple("a", 1, true)
+	a, b, c := tr.Unpack()
+
+	assert.Equal(t, "a", tr.First())
+	assert.Equal(t, 1, tr.Second())
+	assert.True(t, tr.Third())
+	assert.Equal(t, []any{"a", 1, true}, []any{a, b, c})
+	assert.Equal(t, "(a, 1, true)", tr.String())
+}
+
+func TestTuple4(t *testing.T) {
+	tu := NewTuple4("a", 1, true, 1.5)
+	a, b, c, d := tu.Unpack()
+
+	assert.Equal(t, "a", tu.First())
+	assert.Equal(t, 1, tu.Second())
+	assert.True(t, tu.Third())
+	assert.Equal(t, 1.5, tu.Fourth())
+	assert.Equal(t, []any{"a", 1, true, 1.5}, []any{a, b, c, d})
+	assert.Equal(t, "(a, 1, true, 1.5)", tu.String())
+}
+
+func TestTuple5(t *testing.T) {
+	tu := NewTuple5("a", 1, true, 1.5, 'x')
+	a, b, c, d, e := tu.Unpack()
+
+	assert.Equal(t, "a", tu.First())
+	assert.Equal(t, 1, tu.Second())
+	assert.True(t, tu.Third())
+	assert.Equal(t, 1.5, tu.Fourth())
+	assert.Equal(t, 'x', tu.Fifth())
+	assert.Equal(t, []any{"a", 1, true, 1.5, 'x'}, []any{a, b, c, d, e})
+	assert.Equal(t, "(a, 1, true, 1.5, 120)", tu.String())
+}
+
+func TestSwap(t *testing.T) {
+	assert.Equal(t, NewPair(1, "a"), Swap(NewPair("a", 1)))
+}
+
+func TestComparable(t *testing.T) {
+	assert.True(t, NewPair("a", 1) == NewPair("a", 1))
+	assert.False(t, NewTriple("a", 1, true) == NewTriple("a", 1, false))
+}
@@ -0,0 +1,228 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/MisterKaiou/go-functional/result"
+)
+
+// ErrNoErrors is the error held by an Of created with Invalid without any non-nil error.
+var ErrNoErrors = errors.New("validation: invalid value created without errors")
+
+// Of represents a value that is either valid, or invalid together with every error found while validating it. Unlike
+// result.Of, combining several Of with Apply, Map2 to Map6 or Sequence keeps the errors of all of them.
+//
+// The zero value of Of is valid, holding the zero value of its type.
+type Of[T any] struct {
+	value T
+	errs  []error
+}
+
+// The value returned when calling this method depends on the state it represents. If valid return fmt.String applied
+// to its internal value; if invalid, return the message of the error returned by Err.
+func (v *Of[T]) String() string {
+	if v.IsInvalid() {
+		return v.Err().Error()
+	}
+
+	return fmt.Sprint(v.value)
+}
+
+func (v *Of[T]) IsValid() bool {
+	return len(v.errs) == 0
+}
+
+func IsValid[T any](v Of[T]) bool {
+	return v.IsValid()
+}
+
+func (v *Of[T]) IsInvalid() bool {
+	return !v.IsValid()
+}
+
+func IsInvalid[T any](v Of[T]) bool {
+	return v.IsInvalid()
+}
+
+// Unwrap can panic if this Of is invalid. Prefer Match over this
+func (v *Of[T]) Unwrap() T {
+	if v.IsInvalid() {
+		panic("cannot get the value of an invalid validation")
+	}
+
+	return v.value
+}
+
+// Errors returns a copy of the errors of this Of, in the order they were found. It is empty if this Of is valid.
+func (v *Of[T]) Errors() []error {
+	return slices.Clone(v.errs)
+}
+
+func Errors[T any](v Of[T]) []error {
+	return v.Errors()
+}
+
+// Err returns nil if this Of is valid, else all of its errors joined with errors.Join, so errors.Is and errors.As can
+// match any one of them.
+func (v *Of[T]) Err() error {
+	return errors.Join(v.errs...)
+}
+
+// Valid creates a new Of representing a valid state.
+func Valid[T any](it T) Of[T] {
+	return Of[T]{value: it}
+}
+
+// Invalid creates a new Of representing an invalid state holding the given errors. Nil errors are discarded; if none
+// is left, the returned Of holds ErrNoErrors.
+func Invalid[T any](errs ...error) Of[T] {
+	kept := slices.DeleteFunc(slices.Clone(errs), func(err error) bool { return err == nil })
+	if len(kept) == 0 {
+		kept = []error{ErrNoErrors}
+	}
+
+	return Of[T]{errs: kept}
+}
+
+// Validate runs every check against the given value and returns an Of holding all the errors they returned, or the
+// value if none did.
+func Validate[T any](it T, checks ...func(T) error) Of[T] {
+	var errs []error
+	for _, check := range checks {
+		if err := check(it); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return Of[T]{errs: errs}
+	}
+
+	return Valid(it)
+}
+
+// FromResult creates an Of from the given result.Of. An error result becomes invalid, holding its error.
+func FromResult[T any](res result.Of[T]) Of[T] {
+	return result.Match(res, Valid[T], func(err error) Of[T] { return Invalid[T](err) })
+}
+
+// ToResult creates a result.Of from the given Of. If invalid, the returned result holds the error returned by Err.
+func ToResult[T any](v Of[T]) result.Of[T] {
+	if v.IsInvalid() {
+		return result.Error[T](v.Err())
+	}
+
+	return result.Ok(v.value)
+}
+
+// Map applies the mapping function on the Of internal value if it is valid, and returns a new Of.
+func Map[T, To any](v Of[T], mapping func(T) To) Of[To] {
+	if v.IsInvalid() {
+		return Of[To]{errs: v.errs}
+	}
+
+	return Valid(mapping(v.value))
+}
+
+// Bind accepts a function that takes the Of internal value and returns another Of. Since the function needs a valid
+// value, it is not called if v is invalid, and only the errors of v are kept. Use Apply or Map2 to Map6 to keep the
+// errors of independent validations.
+func Bind[T, To any](v Of[T], binding func(T) Of[To]) Of[To] {
+	if v.IsInvalid() {
+		return Of[To]{errs: v.errs}
+	}
+
+	return binding(v.value)
+}
+
+// Match accepts two functions that return a value of the same type, but the first one receives the value contained in
+// the Of and the second one receives its errors.
+func Match[T, To any](v Of[T], valid func(T) To, invalid func([]error) To) To {
+	if v.IsInvalid() {
+		return invalid(v.Errors())
+	}
+
+	return valid(v.value)
+}
+
+// Apply applies the function held by fn on the value held by v if both are valid. Otherwise, the returned Of holds the
+// errors of fn followed by the errors of v.
+func Apply[T, To any](fn Of[func(T) To], v Of[T]) Of[To] {
+	if fn.IsInvalid() || v.IsInvalid() {
+		return Of[To]{errs: slices.Concat(fn.errs, v.errs)}
+	}
+
+	return Valid(fn.value(v.value))
+}
+
+// Map2 applies the mapping function on the values of the given Of if all of them are valid. Otherwise, the returned Of
+// holds the errors of all of them, in order.
+func Map2[T1, T2, To any](v1 Of[T1], v2 Of[T2], mapping func(T1, T2) To) Of[To] {
+	if errs := slices.Concat(v1.errs, v2.errs); len(errs) > 0 {
+		return Of[To]{errs: errs}
+	}
+
+	return Valid(mapping(v1.value, v2.value))
+}
+
+// Map3 applies the mapping function on the values of the given Of if all of them are valid. Otherwise, the returned Of
+// holds the errors of all of them, in order.
+func Map3[T1, T2, T3, To any](v1 Of[T1], v2 Of[T2], v3 Of[T3], mapping func(T1, T2, T3) To) Of[To] {
+	if errs := slices.Concat(v1.errs, v2.errs, v3.errs); len(errs) > 0 {
+		return Of[To]{errs: errs}
+	}
+
+	return Valid(mapping(v1.value, v2.value, v3.value))
+}
+
+// Map4 applies the mapping function on the values of the given Of if all of them are valid. Otherwise, the returned Of
+// holds the errors of all of them, in order.
+func Map4[T1, T2, T3, T4, To any](
+	v1 Of[T1], v2 Of[T2], v3 Of[T3], v4 Of[T4], mapping func(T1, T2, T3, T4) To) Of[To] {
+	if errs := slices.Concat(v1.errs, v2.errs, v3.errs, v4.errs); len(errs) > 0 {
+		return Of[To]{errs: errs}
+	}
+
+	return Valid(mapping(v1.value, v2.value, v3.value, v4.value))
+}
+
+// Map5 applies the mapping function on the values of the given Of if all of them are valid. Otherwise, the returned Of
+// holds the errors of all of them, in order.
+func Map5[T1, T2, T3, T4, T5, To any](
+	v1 Of[T1], v2 Of[T2], v3 Of[T3], v4 Of[T4], v5 Of[T5], mapping func(T1, T2, T3, T4, T5) To) Of[To] {
+	if errs := slices.Concat(v1.errs, v2.errs, v3.errs, v4.errs, v5.errs); len(errs) > 0 {
+		return Of[To]{errs: errs}
+	}
+
+	return Valid(mapping(v1.value, v2.value, v3.value, v4.value, v5.value))
+}
+
+// Map6 applies the mapping function on the values of the given Of if all of them are valid. Otherwise, the returned Of
+// holds the errors of all of them, in order.
+func Map6[T1, T2, T3, T4, T5, T6, To any](
+	v1 Of[T1], v2 Of[T2], v3 Of[T3], v4 Of[T4], v5 Of[T5], v6 Of[T6], mapping func(T1, T2, T3, T4, T5, T6) To) Of[To] {
+	if errs := slices.Concat(v1.errs, v2.errs, v3.errs, v4.errs, v5.errs, v6.errs); len(errs) > 0 {
+		return Of[To]{errs: errs}
+	}
+
+	return Valid(mapping(v1.value, v2.value, v3.value, v4.value, v5.value, v6.value))
+}
+
+// Sequence turns a slice of Of into an Of of a slice. It is valid if every element is valid, else it holds the errors
+// of all the invalid elements, in order.
+func Sequence[T any](vs []Of[T]) Of[[]T] {
+	var errs []error
+	values := make([]T, 0, len(vs))
+	for _, v := range vs {
+		errs = append(errs, v.errs...)
+		values = append(values, v.value)
+	}
+
+	if len(errs) > 0 {
+		return Of[[]T]{errs: errs}
+	}
+
+	return Valid(values)
+}
@@ -0,0 +1,218 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/MisterKaiou/go-functional/result"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	errEmptyName = errors.New("name must not be empty")
+	errAge       = errors.New("age must be positive")
+	errEmail     = errors.New("email must contain @")
+)
+
+type signup struct {
+	Name  string
+	Age   int
+	Email string
+}
+
+func validateName(name string) Of[string] {
+	if name == "" {
+		return Invalid[string](errEmptyName)
+	}
+
+	return Valid(name)
+}
+
+func validateAge(age int) Of[int] {
+	if age <= 0 {
+		return Invalid[int](errAge)
+	}
+
+	return Valid(age)
+}
+
+func validateEmail(email string) Of[string] {
+	if !strings.Contains(email, "@") {
+		return Invalid[string](errEmail)
+	}
+
+	return Valid(email)
+}
+
+func newSignup(name string, age int, email string) signup {
+	return signup{name, age, email}
+}
+
+func TestZeroValue(t *testing.T) {
+	var v Of[int]
+
+	assert.True(t, v.IsValid())
+	assert.Equal(t, 0, v.Unwrap())
+	assert.NoError(t, v.Err())
+}
+
+func TestValid(t *testing.T) {
+	v := Valid(42)
+
+	assert.True(t, IsValid(v))
+	assert.Equal(t, 42, v.Unwrap())
+	assert.Empty(t, v.Errors())
+	assert.Equal(t, "42", v.String())
+}
+
+func TestInvalid(t *testing.T) {
+	v := Invalid[int](errAge, nil, errEmail)
+
+	assert.True(t, IsInvalid(v))
+	assert.Equal(t, []error{errAge, errEmail}, v.Errors())
+	assert.Panics(t, func() { v.Unwrap() })
+	assert.Equal(t, "age must be positive\nemail must contain @", v.String())
+}
+
+func TestInvalidWithoutErrors(t *testing.T) {
+	v := Invalid[int](nil)
+	empty := Invalid[int]()
+
+	assert.True(t, v.IsInvalid())
+	assert.True(t, empty.IsInvalid())
+	assert.Equal(t, []error{ErrNoErrors}, v.Errors())
+	assert.Equal(t, []error{ErrNoErrors}, empty.Errors())
+	assert.NotErrorIs(t, v.Err(), result.ErrNilError)
+}
+
+func TestErrorsIsACopy(t *testing.T) {
+	v := Invalid[int](errAge)
+
+	v.Errors()[0] = errEmail
+
+	assert.Equal(t, []error{errAge}, v.Errors())
+}
+
+func TestErrIsJoined(t *testing.T) {
+	v := Invalid[int](errAge, errEmail)
+
+	assert.ErrorIs(t, v.Err(), errAge)
+	assert.ErrorIs(t, v.Err(), errEmail)
+	assert.Equal(t, errors.Join(errAge, errEmail).Error(), v.Err().Error())
+}
+
+func TestValidate(t *testing.T) {
+	notEmpty := func(s string) error {
+		if s == "" {
+			return errEmptyName
+		}
+		return nil
+	}
+	hasAt := func(s string) error {
+		if !strings.Contains(s, "@") {
+			return errEmail
+		}
+		return nil
+	}
+
+	assert.Equal(t, Valid("a@b"), Validate("a@b", notEmpty, hasAt))
+	assert.Equal(t, []error{errEmptyName, errEmail}, Errors(Validate("", notEmpty, hasAt)))
+}
+
+func TestMap(t *testing.T) {
+	assert.Equal(t, Valid("42"), Map(Valid(42), func(it int) string { return fmt.Sprint(it) }))
+	assert.Equal(t, Invalid[string](errAge), Map(Invalid[int](errAge), func(it int) string { return fmt.Sprint(it) }))
+}
+
+func TestBind(t *testing.T) {
+	assert.Equal(t, Valid(42), Bind(Valid(42), validateAge))
+	assert.Equal(t, Invalid[int](errAge), Bind(Valid(-1), validateAge))
+	assert.Equal(t, Invalid[int](errEmail), Bind(Invalid[int](errEmail), validateAge))
+}
+
+func TestMatch(t *testing.T) {
+	count := func(errs []error) int { return len(errs) }
+
+	assert.Equal(t, 42, Match(Valid(42), func(it int) int { return it }, count))
+	assert.Equal(t, 2, Match(Invalid[int](errAge, errEmail), func(it int) int { return it }, count))
+}
+
+func TestApply(t *testing.T) {
+	double := Valid(func(it int) int { return it * 2 })
+	broken := Invalid[func(int) int](errEmptyName)
+
+	assert.Equal(t, Valid(84), Apply(double, Valid(42)))
+	assert.Equal(t, []error{errAge}, Errors(Apply(double, Invalid[int](errAge))))
+	assert.Equal(t, []error{errEmptyName, errAge}, Errors(Apply(broken, Invalid[int](errAge))))
+}
+
+func TestApplyCurried(t *testing.T) {
+	curried := func(name string) func(int) func(string) signup {
+		return func(age int) func(string) signup {
+			return func(email string) signup { return newSignup(name, age, email) }
+		}
+	}
+
+	valid := Apply(Apply(Map(validateName("kaiou"), curried), validateAge(20)), validateEmail("k@example.com"))
+	invalid := Apply(Apply(Map(validateName(""), curried), validateAge(0)), validateEmail("nope"))
+
+	assert.Equal(t, Valid(signup{"kaiou", 20, "k@example.com"}), valid)
+	assert.Equal(t, []error{errEmptyName, errAge, errEmail}, invalid.Errors())
+}
+
+func TestMap2(t *testing.T) {
+	concat := func(a string, b int) string { return fmt.Sprint(a, b) }
+
+	assert.Equal(t, Valid("a1"), Map2(Valid("a"), Valid(1), concat))
+	assert.Equal(t, []error{errEmptyName, errAge}, Errors(Map2(Invalid[string](errEmptyName), Invalid[int](errAge), concat)))
+}
+
+func TestMap3(t *testing.T) {
+	valid := Map3(validateName("kaiou"), validateAge(20), validateEmail("k@example.com"), newSignup)
+	invalid := Map3(validateName(""), validateAge(0), validateEmail("nope"), newSignup)
+	partial := Map3(validateName("kaiou"), validateAge(0), validateEmail("nope"), newSignup)
+
+	assert.Equal(t, Valid(signup{"kaiou", 20, "k@example.com"}), valid)
+	assert.Equal(t, []error{errEmptyName, errAge, errEmail}, invalid.Errors())
+	assert.Equal(t, []error{errAge, errEmail}, partial.Errors())
+}
+
+func TestMap4To6(t *testing.T) {
+	sum4 := func(a, b, c, d int) int { return a + b + c + d }
+	sum5 := func(a, b, c, d, e int) int { return a + b + c + d + e }
+	sum6 := func(a, b, c, d, e, f int) int { return a + b + c + d + e + f }
+	one := Valid(1)
+	bad := Invalid[int](errAge)
+
+	assert.Equal(t, Valid(4), Map4(one, one, one, one, sum4))
+	assert.Equal(t, Valid(5), Map5(one, one, one, one, one, sum5))
+	assert.Equal(t, Valid(6), Map6(one, one, one, one, one, one, sum6))
+	assert.Len(t, Errors(Map4(bad, one, bad, one, sum4)), 2)
+	assert.Len(t, Errors(Map5(bad, one, bad, one, bad, sum5)), 3)
+	assert.Len(t, Errors(Map6(bad, bad, bad, bad, bad, bad, sum6)), 6)
+}
+
+func TestSequence(t *testing.T) {
+	assert.Equal(t, Valid([]int{1, 2, 3}), Sequence([]Of[int]{Valid(1), Valid(2), Valid(3)}))
+	assert.Equal(t, Valid([]int{}), Sequence([]Of[int]{}))
+
+	invalid := Sequence([]Of[int]{Valid(1), Invalid[int](errAge), Valid(3), Invalid[int](errEmail)})
+
+	assert.Equal(t, []error{errAge, errEmail}, invalid.Errors())
+}
+
+func TestResultConversions(t *testing.T) {
+	err := errors.New("error")
+
+	assert.Equal(t, Valid(42), FromResult(result.Ok(42)))
+	assert.Equal(t, Invalid[int](err), FromResult(result.Error[int](err)))
+	assert.Equal(t, result.Ok(42), ToResult(Valid(42)))
+
+	res := ToResult(Invalid[int](errAge, errEmail))
+
+	assert.True(t, res.IsError())
+	assert.ErrorIs(t, res.UnwrapError(), errAge)
+	assert.ErrorIs(t, res.UnwrapError(), errEmail)
+}
@@ -0,0 +1,52 @@
+package vector
+
+// Builder builds an Of by appending values to it in place, which avoids copying the last values of the vector, and the
+// nodes of the trie it owns, on every Append. The vectors returned by Build are never changed by it afterward.
+//
+// The zero value of Builder is ready to use and builds an Of starting from an empty one. A Builder must not be used by
+// multiple goroutines at the same time.
+type Builder[T any] struct {
+	vector Of[T]
+	edit   *owner
+	// ownsTail reports whether the tail of vector was allocated by this Builder since its last Build, so it can be
+	// appended to in place.
+	ownsTail bool
+}
+
+// ToBuilder creates a new Builder that starts from the given Of, which is left untouched.
+func ToBuilder[T any](v Of[T]) *Builder[T] {
+	return &Builder[T]{vector: v}
+}
+
+// Len returns the number of values in the Of being built.
+func (b *Builder[T]) Len() int {
+	return b.vector.len
+}
+
+// Append adds it after the last value of the Of being built.
+func (b *Builder[T]) Append(it T) {
+	if b.edit == nil {
+		b.edit = &owner{}
+	}
+
+	v := &b.vector
+	if len(v.tail) == width {
+		v.root, v.shift = v.pushTail(b.edit)
+		v.tail, b.ownsTail = nil, false
+	}
+
+	if !b.ownsTail {
+		tail := make([]T, len(v.tail), width)
+		copy(tail, v.tail)
+		v.tail, b.ownsTail = tail, true
+	}
+
+	v.tail = append(v.tail, it)
+	v.len++
+}
+
+// Build returns the Of built so far. The Builder can still be used afterward, without changing the returned Of.
+func (b *Builder[T]) Build() Of[T] {
+	b.edit, b.ownsTail = nil, false
+	return b.vector
+}
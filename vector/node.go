@@ -0,0 +1,111 @@
+package vector
+
+import "slices"
+
+const (
+	bitsPerLevel = 5
+	width        = 1 << bitsPerLevel
+	levelMask    = width - 1
+)
+
+// owner marks the nodes a Builder may change in place. It is not empty so that every owner has its own address.
+type owner struct {
+	_ byte
+}
+
+// node is a level of the trie. Leaves hold exactly width values, and every other node holds up to width children,
+// filled from the left.
+type node[T any] struct {
+	edit     *owner
+	children []*node[T]
+	values   []T
+}
+
+// editable returns n itself if edit owns it, or a copy of it owned by edit. A nil n gives a new empty node.
+func (n *node[T]) editable(edit *owner) *node[T] {
+	if n == nil {
+		return &node[T]{edit: edit}
+	}
+
+	if edit != nil && n.edit == edit {
+		return n
+	}
+
+	return &node[T]{edit: edit, children: slices.Clone(n.children), values: slices.Clone(n.values)}
+}
+
+// newPath returns the chain of nodes that leads from the level given by shift down to leaf.
+func newPath[T any](edit *owner, shift uint, leaf *node[T]) *node[T] {
+	if shift == 0 {
+		return leaf
+	}
+
+	return &node[T]{edit: edit, children: []*node[T]{newPath(edit, shift-bitsPerLevel, leaf)}}
+}
+
+// pushTail returns parent with leaf added after its last value. length is the length of the vector before leaf is
+// added, including the values of leaf itself.
+func pushTail[T any](edit *owner, length int, shift uint, parent *node[T], leaf *node[T]) *node[T] {
+	n := parent.editable(edit)
+	index := ((length - 1) >> shift) & levelMask
+
+	var child *node[T]
+	switch {
+	case shift == bitsPerLevel:
+		child = leaf
+	case index < len(n.children):
+		child = pushTail(edit, length, shift-bitsPerLevel, n.children[index], leaf)
+	default:
+		child = newPath(edit, shift-bitsPerLevel, leaf)
+	}
+
+	if index < len(n.children) {
+		n.children[index] = child
+	} else {
+		n.children = append(n.children, child)
+	}
+
+	return n
+}
+
+// popTail returns n without its last leaf, or nil if nothing would be left. length is the length of the vector before
+// the leaf is removed.
+func popTail[T any](length int, shift uint, n *node[T]) *node[T] {
+	index := ((length - 2) >> shift) & levelMask
+	if shift > bitsPerLevel {
+		child := popTail(length, shift-bitsPerLevel, n.children[index])
+		if child == nil && index == 0 {
+			return nil
+		}
+
+		popped := n.editable(nil)
+		if child == nil {
+			popped.children = popped.children[:index]
+		} else {
+			popped.children[index] = child
+		}
+
+		return popped
+	}
+
+	if index == 0 {
+		return nil
+	}
+
+	popped := n.editable(nil)
+	popped.children = popped.children[:index]
+	return popped
+}
+
+// set returns a copy of n with the value at i replaced. Only the nodes in the path to i are copied.
+func set[T any](shift uint, n *node[T], i int, it T) *node[T] {
+	updated := n.editable(nil)
+	if shift == 0 {
+		updated.values[i&levelMask] = it
+		return updated
+	}
+
+	index := (i >> shift) & levelMask
+	updated.children[index] = set(shift-bitsPerLevel, n.children[index], i, it)
+	return updated
+}
@@ -0,0 +1,221 @@
+// Package vector provides a persistent vector, implemented as a 32-way trie. Updates never change an existing vector:
+// they return a new one that shares every part of the trie they did not touch.
+package vector
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/MisterKaiou/go-functional/option"
+	"github.com/MisterKaiou/go-functional/result"
+)
+
+// ErrIndexOutOfRange is the error held by the results of Set and Slice when given an index outside of the vector.
+var ErrIndexOutOfRange = errors.New("vector: index out of range")
+
+// Of represents an immutable sequence of values of type T that can be indexed. Reading or replacing a value takes time
+// proportional to the depth of the trie, which grows with the logarithm, in base 32, of its length. The last values are
+// kept outside the trie, so adding or removing them takes constant time in most cases.
+//
+// The zero value of Of is an empty vector.
+type Of[T any] struct {
+	len   int
+	shift uint
+	root  *node[T]
+	tail  []T
+}
+
+// Empty creates a new empty Of.
+func Empty[T any]() Of[T] {
+	return Of[T]{}
+}
+
+// New creates a new Of holding the given items, in order.
+func New[T any](items ...T) Of[T] {
+	return FromSlice(items)
+}
+
+// FromSlice creates a new Of holding the items of the given slice, in order. The slice is not retained.
+func FromSlice[T any](items []T) Of[T] {
+	var b Builder[T]
+	for _, it := range items {
+		b.Append(it)
+	}
+
+	return b.Build()
+}
+
+// Len returns the number of values in this Of.
+func (v Of[T]) Len() int {
+	return v.len
+}
+
+// All returns an iterator that yields the values of this Of, in order.
+func (v Of[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < v.len; i += width {
+			for _, it := range v.leafFor(i) {
+				if !yield(it) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// At returns Some with the value at index i of the given Of, or None if i is out of its range.
+func At[T any](v Of[T], i int) option.Of[T] {
+	if i < 0 || i >= v.len {
+		return option.None[T]()
+	}
+
+	return option.Some(v.leafFor(i)[i&levelMask])
+}
+
+// Last returns Some with the last value of the given Of, or None if it is empty.
+func Last[T any](v Of[T]) option.Of[T] {
+	return At(v, v.len-1)
+}
+
+// Set returns a new Of with it at index i, leaving the given Of untouched. If i is out of its range, the result is an
+// error wrapping ErrIndexOutOfRange.
+func Set[T any](v Of[T], i int, it T) result.Of[Of[T]] {
+	if i < 0 || i >= v.len {
+		return result.Error[Of[T]](outOfRange(i, v.len))
+	}
+
+	if i >= v.tailOffset() {
+		tail := slices.Clone(v.tail)
+		tail[i&levelMask] = it
+		return result.Ok(Of[T]{len: v.len, shift: v.shift, root: v.root, tail: tail})
+	}
+
+	return result.Ok(Of[T]{len: v.len, shift: v.shift, root: set(v.shift, v.root, i, it), tail: v.tail})
+}
+
+// Append returns a new Of with it after the last value of the given one, which is left untouched.
+func Append[T any](v Of[T], it T) Of[T] {
+	if len(v.tail) < width {
+		tail := make([]T, len(v.tail)+1)
+		copy(tail, v.tail)
+		tail[len(v.tail)] = it
+		return Of[T]{len: v.len + 1, shift: v.shift, root: v.root, tail: tail}
+	}
+
+	root, shift := v.pushTail(nil)
+	return Of[T]{len: v.len + 1, shift: shift, root: root, tail: []T{it}}
+}
+
+// Pop returns Some with a new Of holding every value of the given one but the last, or None if it is empty. The given
+// Of is left untouched.
+func Pop[T any](v Of[T]) option.Of[Of[T]] {
+	switch {
+	case v.len == 0:
+		return option.None[Of[T]]()
+	case v.len == 1:
+		return option.Some(Empty[T]())
+	case len(v.tail) > 1:
+		return option.Some(Of[T]{len: v.len - 1, shift: v.shift, root: v.root, tail: v.tail[:len(v.tail)-1]})
+	}
+
+	tail := v.leafFor(v.len - 2)
+	root, shift := popTail(v.len, v.shift, v.root), v.shift
+	if shift > bitsPerLevel && len(root.children) == 1 {
+		root, shift = root.children[0], shift-bitsPerLevel
+	}
+
+	return option.Some(Of[T]{len: v.len - 1, shift: shift, root: root, tail: tail})
+}
+
+// Slice returns a new Of holding the values of the given one from index start up to, but not including, index end. If
+// the indexes are out of its range, or start is after end, the result is an error wrapping ErrIndexOutOfRange. It takes
+// time proportional to the length of the returned Of.
+func Slice[T any](v Of[T], start int, end int) result.Of[Of[T]] {
+	if start < 0 || end > v.len || start > end {
+		return result.Error[Of[T]](fmt.Errorf("%w: [%d:%d] with length %d", ErrIndexOutOfRange, start, end, v.len))
+	}
+
+	var b Builder[T]
+	for i := start; i < end; {
+		leaf := v.leafFor(i)
+		from := i & levelMask
+		to := min(len(leaf), from+end-i)
+		for _, it := range leaf[from:to] {
+			b.Append(it)
+		}
+
+		i += to - from
+	}
+
+	return result.Ok(b.Build())
+}
+
+// Map creates a new Of holding the result of applying the mapping function to every value of the given one, in order.
+func Map[T, To any](v Of[T], mapping func(T) To) Of[To] {
+	var b Builder[To]
+	for it := range v.All() {
+		b.Append(mapping(it))
+	}
+
+	return b.Build()
+}
+
+// Fold applies the folder function to every value of the given Of, in order, threading the state through. It returns
+// the final state.
+func Fold[T, State any](v Of[T], state State, folder func(State, T) State) State {
+	for it := range v.All() {
+		state = folder(state, it)
+	}
+
+	return state
+}
+
+// ToSlice returns the values of the given Of in a new slice, in order.
+func ToSlice[T any](v Of[T]) []T {
+	items := make([]T, 0, v.len)
+	for it := range v.All() {
+		items = append(items, it)
+	}
+
+	return items
+}
+
+// tailOffset returns the index of the first value kept in the tail.
+func (v Of[T]) tailOffset() int {
+	return v.len - len(v.tail)
+}
+
+// leafFor returns the values of the leaf, or tail, that holds index i.
+func (v Of[T]) leafFor(i int) []T {
+	if i >= v.tailOffset() {
+		return v.tail
+	}
+
+	n := v.root
+	for shift := v.shift; shift > 0; shift -= bitsPerLevel {
+		n = n.children[(i>>shift)&levelMask]
+	}
+
+	return n.values
+}
+
+// pushTail returns the root and shift of the trie after moving the full tail of v into it, as a new leaf owned by edit.
+func (v Of[T]) pushTail(edit *owner) (*node[T], uint) {
+	leaf := &node[T]{edit: edit, values: v.tail}
+	shift := max(v.shift, bitsPerLevel)
+	if v.root == nil {
+		return &node[T]{edit: edit, children: []*node[T]{leaf}}, shift
+	}
+
+	if v.len>>bitsPerLevel > 1<<shift {
+		return &node[T]{edit: edit, children: []*node[T]{v.root, newPath(edit, shift, leaf)}}, shift + bitsPerLevel
+	}
+
+	return pushTail(edit, v.len, shift, v.root, leaf), shift
+}
+
+func outOfRange(i int, length int) error {
+	return fmt.Errorf("%w: %d with length %d", ErrIndexOutOfRange, i, length)
+}
@@ -0,0 +1,241 @@
+package vector
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/MisterKaiou/go-functional/option"
+	"github.com/MisterKaiou/go-functional/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZeroValue(t *testing.T) {
+	var v Of[int]
+
+	assert.Equal(t, 0, v.Len())
+	assert.Equal(t, option.None[int](), At(v, 0))
+	assert.Equal(t, option.None[int](), Last(v))
+	assert.Equal(t, option.None[Of[int]](), Pop(v))
+	assert.Equal(t, []int{1}, ToSlice(Append(v, 1)))
+}
+
+func TestAppendAndPopAcrossLevels(t *testing.T) {
+	const size = width*width*width + 2*width + 1
+	versions := []Of[int]{Empty[int]()}
+	for i := range size {
+		versions = append(versions, Append(versions[i], i))
+	}
+
+	for _, length := range []int{0, 1, 31, 32, 33, 64, 65, 1055, 1056, 1057, 1088, size - 1, size} {
+		v := versions[length]
+
+		assert.Equal(t, length, v.Len())
+		assert.Equal(t, iota(length), ToSlice(v))
+		assert.Equal(t, option.None[int](), At(v, length))
+		if length > 0 {
+			assert.Equal(t, option.Some(length-1), Last(v))
+			popped := Pop(v)
+			assert.Equal(t, iota(length-1), ToSlice(popped.Unwrap()))
+		}
+	}
+
+	v := versions[size]
+	for v.Len() > 0 {
+		popped := Pop(v)
+		v = popped.Unwrap()
+	}
+
+	assert.Equal(t, 0, v.Len())
+	assert.Equal(t, iota(size), ToSlice(versions[size]))
+}
+
+func TestSet(t *testing.T) {
+	v := FromSlice(iota(100))
+
+	updated := Set(v, 10, -1)
+	inTail := Set(updated.Unwrap(), 99, -2)
+
+	assert.Equal(t, option.Some(-1), At(inTail.Unwrap(), 10))
+	assert.Equal(t, option.Some(-2), At(inTail.Unwrap(), 99))
+	assert.Equal(t, iota(100), ToSlice(v))
+	assert.Equal(t, option.Some(99), At(updated.Unwrap(), 99))
+}
+
+func TestSetOutOfRange(t *testing.T) {
+	v := New(1, 2)
+
+	for _, i := range []int{-1, 2} {
+		res := Set(v, i, 0)
+
+		assert.True(t, res.IsError())
+		assert.ErrorIs(t, res.UnwrapError(), ErrIndexOutOfRange)
+	}
+}
+
+func TestSlice(t *testing.T) {
+	v := FromSlice(iota(100))
+
+	sliced := Slice(v, 30, 70)
+
+	assert.Equal(t, iota(70)[30:], ToSlice(sliced.Unwrap()))
+	assert.Equal(t, result.Ok(Empty[int]()), Slice(v, 100, 100))
+	for _, bounds := range [][2]int{{10, 5}, {0, 101}, {-1, 5}} {
+		res := Slice(v, bounds[0], bounds[1])
+
+		assert.ErrorIs(t, res.UnwrapError(), ErrIndexOutOfRange)
+	}
+}
+
+func TestMapAndFold(t *testing.T) {
+	v := FromSlice(iota(100))
+
+	doubled := Map(v, func(it int) string { return fmt.Sprint(it * 2) })
+	sum := Fold(v, 0, func(sum int, it int) int { return sum + it })
+
+	assert.Equal(t, option.Some("198"), At(doubled, 99))
+	assert.Equal(t, 100, doubled.Len())
+	assert.Equal(t, 4950, sum)
+}
+
+func TestBuilderDoesNotChangeBuiltVectors(t *testing.T) {
+	source := FromSlice(iota(40))
+	b := ToBuilder(source)
+
+	b.Append(40)
+	built := b.Build()
+	for i := 41; i < 2000; i++ {
+		b.Append(i)
+	}
+
+	assert.Equal(t, iota(40), ToSlice(source))
+	assert.Equal(t, iota(41), ToSlice(built))
+	assert.Equal(t, iota(2000), ToSlice(b.Build()))
+	assert.Equal(t, 2000, b.Len())
+}
+
+// TestAgainstSliceModel applies random operations to a few versions of an Of and checks all of them against slices
+// that received the same operations.
+func TestAgainstSliceModel(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	versions := []Of[int]{Empty[int]()}
+	models := [][]int{{}}
+
+	for range 20000 {
+		i := rng.Intn(len(versions))
+		v, model := versions[i], models[i]
+
+		var next Of[int]
+		var expected []int
+		switch op := rng.Intn(10); {
+		case op < 6:
+			it := rng.Int()
+			next, expected = Append(v, it), append(slices.Clone(model), it)
+		case op < 8:
+			if len(model) == 0 {
+				continue
+			}
+			index, it := rng.Intn(len(model)), rng.Int()
+			expected = slices.Clone(model)
+			expected[index] = it
+			res := Set(v, index, it)
+			next = res.Unwrap()
+		default:
+			if len(model) == 0 {
+				continue
+			}
+			next, expected = option.DefaultValue(Pop(v), Empty[int]()), slices.Clone(model[:len(model)-1])
+		}
+
+		if len(versions) < 20 {
+			versions, models = append(versions, next), append(models, expected)
+		} else {
+			versions[i], models[i] = next, expected
+		}
+	}
+
+	for i, v := range versions {
+		assert.Equal(t, models[i], ToSlice(v))
+		for index, it := range models[i] {
+			assert.Equal(t, option.Some(it), At(v, index))
+		}
+	}
+}
+
+func iota(n int) []int {
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i
+	}
+
+	return items
+}
+
+func BenchmarkAppend(b *testing.B) {
+	const size = 10000
+
+	b.Run("vector", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			v := Empty[int]()
+			for j := range size {
+				v = Append(v, j)
+			}
+		}
+	})
+
+	b.Run("builder", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var builder Builder[int]
+			for j := range size {
+				builder.Append(j)
+			}
+			builder.Build()
+		}
+	})
+
+	b.Run("slice", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var items []int
+			for j := range size {
+				items = append(items, j)
+			}
+		}
+	})
+}
+
+func BenchmarkSetVsCopy(b *testing.B) {
+	for _, size := range []int{100, 10000, 1000000} {
+		items := iota(size)
+		v := FromSlice(items)
+
+		b.Run(fmt.Sprint("vector/", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				Set(v, i%size, i)
+			}
+		})
+
+		b.Run(fmt.Sprint("slice copy/", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				updated := slices.Clone(items)
+				updated[i%size] = i
+			}
+		})
+	}
+}
+
+func BenchmarkAt(b *testing.B) {
+	const size = 1000000
+	v := FromSlice(iota(size))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		At(v, i%size)
+	}
+}
@@ -0,0 +1,120 @@
+package writer
+
+import (
+	"slices"
+
+	"github.com/MisterKaiou/go-functional/result"
+	"github.com/MisterKaiou/go-functional/unit"
+)
+
+// Monoid is implemented by the logs accumulated by an Of. Combine appends other to the receiver, without modifying
+// either of them, and the zero value of the type must be the empty log.
+type Monoid[W any] interface {
+	Combine(other W) W
+}
+
+// Log is a Monoid that accumulates entries in a slice.
+type Log[T any] []T
+
+func (l Log[T]) Combine(other Log[T]) Log[T] {
+	return slices.Concat(l, other)
+}
+
+// Text is a Monoid that accumulates a string.
+type Text string
+
+func (t Text) Combine(other Text) Text {
+	return t + other
+}
+
+// Of represents a value of type A paired with a log of type W accumulated while producing it.
+//
+// The zero value of Of holds the zero value of A and an empty log.
+type Of[W Monoid[W], A any] struct {
+	value A
+	log   W
+}
+
+// Result represents a result.Of[A] paired with a log of type W. Functions like BindOk stop at the first error, but
+// the log accumulated up to that point is kept.
+type Result[W Monoid[W], A any] = Of[W, result.Of[A]]
+
+// New creates a new Of holding the given value and log.
+func New[W Monoid[W], A any](it A, log W) Of[W, A] {
+	return Of[W, A]{
+		value: it,
+		log:   log,
+	}
+}
+
+// Pure creates a new Of holding the given value and an empty log.
+func Pure[W Monoid[W], A any](it A) Of[W, A] {
+	return Of[W, A]{value: it}
+}
+
+// Tell creates a new Of holding the given log.
+func Tell[W Monoid[W]](log W) Of[W, unit.Unit] {
+	return New(unit.Unit{}, log)
+}
+
+// Listen creates a new Of with the same log as m, holding the result of applying f to both the value and the log of m.
+func Listen[W Monoid[W], A, To any](m Of[W, A], f func(A, W) To) Of[W, To] {
+	return New(f(m.value, m.log), m.log)
+}
+
+// Censor creates a new Of with the same value as m, and a log that is the result of applying f to the log of m.
+func Censor[W Monoid[W], A any](m Of[W, A], f func(W) W) Of[W, A] {
+	return New(m.value, f(m.log))
+}
+
+// Map applies the mapping function on the value of m, and returns a new Of with the same log.
+func Map[W Monoid[W], A, To any](m Of[W, A], mapping func(A) To) Of[W, To] {
+	return New(mapping(m.value), m.log)
+}
+
+// Bind accepts a function that takes the value of m and returns another Of. The log of the returned Of is appended to
+// the log of m.
+func Bind[W Monoid[W], A, To any](m Of[W, A], binding func(A) Of[W, To]) Of[W, To] {
+	next := binding(m.value)
+	return New(next.value, m.log.Combine(next.log))
+}
+
+// Run returns the value and the log of m.
+func Run[W Monoid[W], A any](m Of[W, A]) (A, W) {
+	return m.value, m.log
+}
+
+// Ok creates a new Result holding Ok with the given value and an empty log.
+func Ok[W Monoid[W], A any](it A) Result[W, A] {
+	return Pure[W](result.Ok(it))
+}
+
+// Error creates a new Result holding an error result with the given error and an empty log.
+func Error[W Monoid[W], A any](err error) Result[W, A] {
+	return Pure[W](result.Error[A](err))
+}
+
+// FromResult creates a new Result holding the given result and an empty log.
+func FromResult[W Monoid[W], A any](res result.Of[A]) Result[W, A] {
+	return Pure[W](res)
+}
+
+// Lift creates a new Result holding Ok with the value of m, and the same log.
+func Lift[W Monoid[W], A any](m Of[W, A]) Result[W, A] {
+	return Map(m, result.Ok[A])
+}
+
+// MapOk applies the mapping function on the value of m, if it is Ok, and returns a new Result with the same log.
+func MapOk[W Monoid[W], A, To any](m Result[W, A], mapping func(A) To) Result[W, To] {
+	return Map(m, func(res result.Of[A]) result.Of[To] { return result.Map(res, mapping) })
+}
+
+// BindOk accepts a function that takes the value of m, if it is Ok, and returns another Result whose log is appended to
+// the log of m. If m holds an error, the binding function is not called and the log of m is kept as is.
+func BindOk[W Monoid[W], A, To any](m Result[W, A], binding func(A) Result[W, To]) Result[W, To] {
+	if m.value.IsError() {
+		return New(result.Error[To](m.value.UnwrapError()), m.log)
+	}
+
+	return Bind(m, func(res result.Of[A]) Result[W, To] { return binding(res.Unwrap()) })
+}
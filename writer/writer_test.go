@@ -0,0 +1,159 @@
+package writer
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/MisterKaiou/go-functional/result"
+	"github.com/MisterKaiou/go-functional/unit"
+	"github.com/stretchr/testify/assert"
+)
+
+// count is a user supplied Monoid that sums the number of steps taken.
+type count int
+
+func (c count) Combine(other count) count {
+	return c + other
+}
+
+func TestLogCombineDoesNotAlias(t *testing.T) {
+	base := make(Log[string], 1, 10)
+	base[0] = "base"
+
+	left := base.Combine(Log[string]{"left"})
+	right := base.Combine(Log[string]{"right"})
+
+	assert.Equal(t, Log[string]{"base", "left"}, left)
+	assert.Equal(t, Log[string]{"base", "right"}, right)
+}
+
+func TestZeroValue(t *testing.T) {
+	var m Of[Text, int]
+
+	it, log := Run(m)
+
+	assert.Equal(t, 0, it)
+	assert.Equal(t, Text(""), log)
+}
+
+func TestNewAndPure(t *testing.T) {
+	it, log := Run(New(42, Log[string]{"created"}))
+	pure, empty := Run(Pure[Log[string]](42))
+
+	assert.Equal(t, 42, it)
+	assert.Equal(t, Log[string]{"created"}, log)
+	assert.Equal(t, 42, pure)
+	assert.Empty(t, empty)
+}
+
+func TestTell(t *testing.T) {
+	it, log := Run(Tell(Text("hello")))
+
+	assert.Equal(t, unit.Unit{}, it)
+	assert.Equal(t, Text("hello"), log)
+}
+
+func TestMap(t *testing.T) {
+	it, log := Run(Map(New(42, Text("log")), func(it int) string { return fmt.Sprint(it) }))
+
+	assert.Equal(t, "42", it)
+	assert.Equal(t, Text("log"), log)
+}
+
+func TestBind(t *testing.T) {
+	double := func(it int) Of[Log[string], int] {
+		return Map(Tell(Log[string]{fmt.Sprint("doubling ", it)}), func(unit.Unit) int { return it * 2 })
+	}
+
+	it, log := Run(Bind(Bind(New(1, Log[string]{"start"}), double), double))
+
+	assert.Equal(t, 4, it)
+	assert.Equal(t, Log[string]{"start", "doubling 1", "doubling 2"}, log)
+}
+
+func TestCustomMonoid(t *testing.T) {
+	step := func(it int) Of[count, int] { return New(it+1, count(1)) }
+
+	it, steps := Run(Bind(Bind(Bind(Pure[count](0), step), step), step))
+
+	assert.Equal(t, 3, it)
+	assert.Equal(t, count(3), steps)
+}
+
+func TestListen(t *testing.T) {
+	m := Listen(New(42, Log[string]{"a", "b"}), func(it int, log Log[string]) string {
+		return fmt.Sprintf("%d after %d entries", it, len(log))
+	})
+
+	it, log := Run(m)
+
+	assert.Equal(t, "42 after 2 entries", it)
+	assert.Equal(t, Log[string]{"a", "b"}, log)
+}
+
+func TestCensor(t *testing.T) {
+	it, log := Run(Censor(New(42, Text("secret")), func(Text) Text { return "redacted" }))
+
+	assert.Equal(t, 42, it)
+	assert.Equal(t, Text("redacted"), log)
+}
+
+func TestOkError(t *testing.T) {
+	err := errors.New("error")
+
+	ok, okLog := Run(Ok[Text](42))
+	failed, failedLog := Run(Error[Text, int](err))
+	fromRes, _ := Run(FromResult[Text](result.Ok(1)))
+	lifted, liftedLog := Run(Lift(New(2, Text("log"))))
+
+	assert.Equal(t, result.Ok(42), ok)
+	assert.Empty(t, okLog)
+	assert.Equal(t, result.Error[int](err), failed)
+	assert.Empty(t, failedLog)
+	assert.Equal(t, result.Ok(1), fromRes)
+	assert.Equal(t, result.Ok(2), lifted)
+	assert.Equal(t, Text("log"), liftedLog)
+}
+
+func TestMapOk(t *testing.T) {
+	err := errors.New("error")
+
+	ok, _ := Run(MapOk(Ok[Text]("value"), strings.ToUpper))
+	failed, log := Run(MapOk(Censor(Error[Text, string](err), func(Text) Text { return "kept" }), strings.ToUpper))
+
+	assert.Equal(t, result.Ok("VALUE"), ok)
+	assert.Equal(t, result.Error[string](err), failed)
+	assert.Equal(t, Text("kept"), log)
+}
+
+func withdraw(amount int) func(int) Result[Log[string], int] {
+	return func(balance int) Result[Log[string], int] {
+		if amount > balance {
+			return New(result.Error[int](errors.New("insufficient funds")), Log[string]{fmt.Sprint("denied ", amount)})
+		}
+
+		return New(result.Ok(balance-amount), Log[string]{fmt.Sprint("withdrew ", amount)})
+	}
+}
+
+func TestBindOkKeepsLogOnError(t *testing.T) {
+	calls := 0
+	audited := func(balance int) Result[Log[string], int] { calls++; return Ok[Log[string]](balance) }
+	m := BindOk(BindOk(BindOk(Ok[Log[string]](100), withdraw(30)), withdraw(80)), audited)
+
+	res, log := Run(m)
+
+	assert.True(t, result.IsError(res))
+	assert.EqualError(t, res.UnwrapError(), "insufficient funds")
+	assert.Equal(t, Log[string]{"withdrew 30", "denied 80"}, log)
+	assert.Equal(t, 0, calls)
+}
+
+func TestBindOk(t *testing.T) {
+	res, log := Run(BindOk(BindOk(Ok[Log[string]](100), withdraw(30)), withdraw(20)))
+
+	assert.Equal(t, result.Ok(50), res)
+	assert.Equal(t, Log[string]{"withdrew 30", "withdrew 20"}, log)
+}